@@ -0,0 +1,233 @@
+package iso8601
+
+// The carry-over points of each component of a duration in the alternative format,
+// which must not be exceeded as defined by ISO 8601.
+const (
+	maxAlternativeMonths      = 12
+	maxAlternativeDays        = 30
+	maxAlternativeOrdinalDays = 365
+	maxAlternativeHours       = 24
+	maxAlternativeMinutes     = 60
+	maxAlternativeSeconds     = 60
+	maxAlternativeYears       = 9999
+)
+
+// isAlternativeDuration reports whether the duration starting at position i of inp, after the `P` designator,
+// is in the alternative format. That is a year followed by a hyphen (`P0001-02-03`),
+// or at least seven digits for the basic calendar (`P00010203`) or ordinal (`P0001034`) date followed by a time or the end of input.
+func isAlternativeDuration[T input](inp T, i int) bool {
+	n := i
+	for n < len(inp) && isDigit(inp[n]) {
+		n++
+	}
+	switch {
+	case n == i:
+		return false
+	case n < len(inp) && inp[n] == '-':
+		return true
+	case n-i >= 7:
+		return n == len(inp) || inp[n] == 'T'
+	}
+	return false
+}
+
+// parseAlternativeDuration parses a duration in the alternative format starting at position i of inp,
+// after the `P` designator.
+func parseAlternativeDuration[T input](inp T, i int) (Duration, error) {
+	var d Duration
+	var err error
+
+	if d.Years, err = readDigits(inp, i, 4); err != nil {
+		return d, err
+	}
+	i += 4
+
+	extended := i < len(inp) && inp[i] == '-'
+	if extended {
+		i++
+	}
+
+	// An ordinal date has three digits for the days, which are followed by the time or the end of input.
+	n := i
+	for n < len(inp) && isDigit(inp[n]) {
+		n++
+	}
+	ordinal := n-i == 3
+	if ordinal {
+		if d.Days, err = readDigits(inp, i, 3); err != nil {
+			return d, err
+		}
+		i += 3
+	} else {
+		if d.Months, err = readDigits(inp, i, 2); err != nil {
+			return d, err
+		}
+		i += 2
+		if extended {
+			if i == len(inp) || inp[i] != '-' {
+				return d, unexpected(inp, i)
+			}
+			i++
+		}
+		if d.Days, err = readDigits(inp, i, 2); err != nil {
+			return d, err
+		}
+		i += 2
+	}
+
+	if i < len(inp) {
+		if inp[i] != 'T' {
+			return d, newUnexpectedCharacterError(inp[i])
+		}
+		i++
+
+		for c, v := range []*int{&d.Hours, &d.Minutes, &d.Seconds} {
+			if c > 0 && extended {
+				if i == len(inp) || inp[i] != ':' {
+					return d, unexpected(inp, i)
+				}
+				i++
+			}
+			if *v, err = readDigits(inp, i, 2); err != nil {
+				return d, err
+			}
+			i += 2
+		}
+
+		if i < len(inp) && (inp[i] == '.' || inp[i] == ',') {
+			var nfraction int
+			for i++; i < len(inp) && isDigit(inp[i]); i++ {
+				d.Nanoseconds = d.Nanoseconds*10 + int(inp[i]-'0')
+				nfraction++
+				if nfraction > 9 {
+					return d, ErrPrecision
+				}
+			}
+			if nfraction == 0 {
+				return d, unexpected(inp, i)
+			}
+			for j := nfraction; j < 9; j++ {
+				d.Nanoseconds *= 10
+			}
+		}
+	}
+	if i < len(inp) {
+		return d, newUnexpectedCharacterError(inp[i])
+	}
+
+	if err := d.checkAlternativeRange(string(inp), ordinal); err != nil {
+		return Duration{}, err
+	}
+	return d, nil
+}
+
+// checkAlternativeRange validates that no component of d exceeds its carry-over point in the alternative format.
+func (d Duration) checkAlternativeRange(value string, ordinal bool) error {
+	maxDays := maxAlternativeDays
+	if ordinal {
+		maxDays = maxAlternativeOrdinalDays
+	}
+
+	for _, c := range [...]struct {
+		element string
+		given   int
+		max     int
+	}{
+		{"year", d.Years, maxAlternativeYears},
+		{"month", d.Months, maxAlternativeMonths},
+		{"day", d.Days, maxDays},
+		{"hour", d.Hours, maxAlternativeHours},
+		{"minute", d.Minutes, maxAlternativeMinutes},
+		{"second", d.Seconds, maxAlternativeSeconds},
+	} {
+		if c.given < 0 || c.given > c.max {
+			return &RangeError{
+				Value:   value,
+				Element: c.element,
+				Given:   c.given,
+				Min:     0,
+				Max:     c.max,
+			}
+		}
+	}
+	// An hour of 24 is only valid as the end of a day, without any minutes or seconds.
+	if d.Hours == maxAlternativeHours && (d.Minutes != 0 || d.Seconds != 0 || d.Nanoseconds != 0) {
+		return &RangeError{
+			Value:   value,
+			Element: "hour",
+			Given:   d.Hours,
+			Min:     0,
+			Max:     maxAlternativeHours - 1,
+		}
+	}
+	return nil
+}
+
+// FormatAlternative returns d in the alternative format of ISO8601,
+// either extended (`P0001-02-03T04:05:06`) or basic (`P00010203T040506`).
+// The time is omitted if it is zero.
+//
+// The alternative format cannot represent weeks or a component that exceeds its carry-over point,
+// such as 13 months or 25 hours, in which case an *iso8601.RangeError is returned.
+// Weeks may be converted to days beforehand, and Normalize carries over excess minutes, seconds and months.
+func (d Duration) FormatAlternative(basic bool) (string, error) {
+	b, err := d.AppendFormatAlternative(make([]byte, 0, 32), basic)
+	return string(b), err
+}
+
+// AppendFormatAlternative is like FormatAlternative but appends the representation of d to dst and returns the extended buffer.
+func (d Duration) AppendFormatAlternative(dst []byte, basic bool) ([]byte, error) {
+	if d.Weeks != 0 {
+		return dst, &RangeError{
+			Value:   d.String(),
+			Element: "week",
+			Given:   d.Weeks,
+			Min:     0,
+			Max:     0,
+		}
+	}
+	if err := d.checkAlternativeRange(d.String(), false); err != nil {
+		return dst, err
+	}
+
+	if d.Negative && !d.IsZero() {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, 'P')
+	dst = appendInt(dst, d.Years, 4)
+	if !basic {
+		dst = append(dst, '-')
+	}
+	dst = appendInt(dst, d.Months, 2)
+	if !basic {
+		dst = append(dst, '-')
+	}
+	dst = appendInt(dst, d.Days, 2)
+
+	if d.Hours == 0 && d.Minutes == 0 && d.Seconds == 0 && d.Nanoseconds == 0 {
+		return dst, nil
+	}
+	dst = append(dst, 'T')
+	dst = appendInt(dst, d.Hours, 2)
+	if !basic {
+		dst = append(dst, ':')
+	}
+	dst = appendInt(dst, d.Minutes, 2)
+	if !basic {
+		dst = append(dst, ':')
+	}
+	dst = appendInt(dst, d.Seconds, 2)
+	return appendFraction(dst, d.Nanoseconds, PrecisionNanosecond, FormatOptions{}), nil
+}
+
+// readDigits reads exactly n digits from position i of inp.
+func readDigits[T input](inp T, i, n int) (int, error) {
+	var v int
+	for j := i; j < i+n; j++ {
+		if j >= len(inp) || !isDigit(inp[j]) {
+			return 0, unexpected(inp, j)
+		}
+		v = v*10 + int(inp[j]-'0')
+	}
+	return v, nil
+}
@@ -0,0 +1,123 @@
+package iso8601
+
+import (
+	"errors"
+	"testing"
+)
+
+var alternativeCases = []struct {
+	Using  string
+	Expect Duration
+}{
+	{Using: "P0001-02-03T04:05:06", Expect: Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+	{Using: "P00010203T040506", Expect: Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+	{Using: "P0003-06-04T12:30:05.5", Expect: Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5, Nanoseconds: 500000000}},
+	{Using: "P0003-06-04T12:30:05,5", Expect: Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5, Nanoseconds: 500000000}},
+	{Using: "P0001-02-03", Expect: Duration{Years: 1, Months: 2, Days: 3}},
+	{Using: "P00010203", Expect: Duration{Years: 1, Months: 2, Days: 3}},
+	{Using: "P0001-034T04:05:06", Expect: Duration{Years: 1, Days: 34, Hours: 4, Minutes: 5, Seconds: 6}},
+	{Using: "P0001034T040506", Expect: Duration{Years: 1, Days: 34, Hours: 4, Minutes: 5, Seconds: 6}},
+	{Using: "P0000365", Expect: Duration{Days: 365}},
+	{Using: "P0000-12-30T24:00:00", Expect: Duration{Months: 12, Days: 30, Hours: 24}},
+	{Using: "P0000-12-30T23:60:60", Expect: Duration{Months: 12, Days: 30, Hours: 23, Minutes: 60, Seconds: 60}},
+}
+
+func TestParseAlternativeDuration(t *testing.T) {
+	for _, tc := range alternativeCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			d, err := ParseDurationString(tc.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tc.Expect {
+				t.Errorf("ParseDuration = %+v; want %+v", d, tc.Expect)
+			}
+		})
+	}
+}
+
+func TestParseAlternativeDurationInvalid(t *testing.T) {
+	var invalidCases = []struct {
+		Using   string
+		Expect  error
+		Element string
+	}{
+		{Using: "P0001-13-01", Element: "month"},
+		{Using: "P0001-01-31", Element: "day"},
+		{Using: "P0001-366", Element: "day"},
+		{Using: "P0001-01-01T25:00:00", Element: "hour"},
+		{Using: "P0001-01-01T00:61:00", Element: "minute"},
+		{Using: "P0001-01-01T00:00:61", Element: "second"},
+		{Using: "P0001-02-03T24:30:00", Element: "hour"},
+		{Using: "P0001-02-03T24:00:01", Element: "hour"},
+		{Using: "P0001-02-03T24:00:00.5", Element: "hour"},
+		{Using: "P0001-01", Expect: ErrUnexpectedEnd},
+		{Using: "P0001-01-01T", Expect: ErrUnexpectedEnd},
+		{Using: "P0001-01-01T01:02", Expect: ErrUnexpectedEnd},
+		{Using: "P0001-01-01T010203", Expect: UnexpectedCharacterError{Character: '0'}},
+		{Using: "P00010101T01:02:03", Expect: UnexpectedCharacterError{Character: ':'}},
+		{Using: "P001-01-01", Expect: UnexpectedCharacterError{Character: '-'}},
+		{Using: "P0001-01-01X", Expect: UnexpectedCharacterError{Character: 'X'}},
+		{Using: "P0001-01-01T01:02:03.", Expect: ErrUnexpectedEnd},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			_, err := ParseDurationString(tc.Using)
+			if tc.Element != "" {
+				var re *RangeError
+				if !errors.As(err, &re) || re.Element != tc.Element {
+					t.Fatalf("Expected a range error on %q, got %v", tc.Element, err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.Expect) {
+				t.Errorf("ParseDuration expected to return error %v (%T), got %v (%T)", tc.Expect, tc.Expect, err, err)
+			}
+		})
+	}
+}
+
+func TestFormatAlternative(t *testing.T) {
+	var formatCases = []struct {
+		Duration Duration
+		Basic    bool
+		Expect   string
+	}{
+		{Duration: Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}, Expect: "P0001-02-03T04:05:06"},
+		{Duration: Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}, Basic: true, Expect: "P00010203T040506"},
+		{Duration: Duration{Days: 3, Nanoseconds: 250000000}, Expect: "P0000-00-03T00:00:00.25"},
+		{Duration: Duration{Years: 12}, Expect: "P0012-00-00"},
+		{Duration: Duration{}, Expect: "P0000-00-00"},
+		{Duration: Duration{Negative: true, Days: 1}, Expect: "-P0000-00-01"},
+	}
+
+	for _, tc := range formatCases {
+		t.Run(tc.Expect, func(t *testing.T) {
+			s, err := tc.Duration.FormatAlternative(tc.Basic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != tc.Expect {
+				t.Errorf("FormatAlternative = %q; want %q", s, tc.Expect)
+			}
+			if tc.Duration.Negative {
+				return
+			}
+			d, err := ParseDurationString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tc.Duration {
+				t.Errorf("ParseDuration = %+v; want %+v", d, tc.Duration)
+			}
+		})
+	}
+
+	for _, d := range []Duration{{Weeks: 1}, {Months: 13}, {Days: 31}, {Hours: 25}, {Years: 10000}} {
+		var re *RangeError
+		if _, err := d.FormatAlternative(false); !errors.As(err, &re) {
+			t.Errorf("FormatAlternative(%s) expected a range error, got %v", d, err)
+		}
+	}
+}
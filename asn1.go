@@ -0,0 +1,277 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// ASN1Mode selects the encoding rules applied to ASN.1 GeneralizedTime and UTCTime values,
+// as used by X.509 certificates, LDAP and SNMP.
+type ASN1Mode uint8
+
+const (
+	// DER applies the Distinguished Encoding Rules, which only accept the canonical form of a time:
+	// in UTC with the `Z` designator, with seconds, and with a fraction of a second in a GeneralizedTime
+	// that uses `.` and has no trailing zeros.
+	DER ASN1Mode = iota
+	// BER applies the Basic Encoding Rules, which also accept times without seconds, numeric zone offsets,
+	// and a GeneralizedTime without seconds or minutes whose fraction, using `.` or `,`, applies to the last component given.
+	// A GeneralizedTime without zone information is a local time, which is only accepted by ParseGeneralizedTimeInLocation.
+	BER
+)
+
+// ParseGeneralizedTime parses an ASN.1 GeneralizedTime, such as `20230102150405.5Z`.
+// A local time without zone information returns ErrMissingZone, as the location it is in is not known.
+// If any component is not within the expected range then an *iso8601.RangeError is returned.
+func (m ASN1Mode) ParseGeneralizedTime(inp []byte) (time.Time, error) {
+	return parseASN1Time(inp, m, false, nil)
+}
+
+// ParseGeneralizedTimeString parses an ASN.1 GeneralizedTime string. See ParseGeneralizedTime for the accepted input.
+func (m ASN1Mode) ParseGeneralizedTimeString(inp string) (time.Time, error) {
+	return parseASN1Time(inp, m, false, nil)
+}
+
+// ParseGeneralizedTimeInLocation parses an ASN.1 GeneralizedTime like ParseGeneralizedTime.
+// If the input does not have timezone information, which BER allows for a local time, it will use the given location.
+func (m ASN1Mode) ParseGeneralizedTimeInLocation(inp []byte, loc *time.Location) (time.Time, error) {
+	return parseASN1Time(inp, m, false, loc)
+}
+
+// ParseGeneralizedTimeStringInLocation parses an ASN.1 GeneralizedTime string like ParseGeneralizedTimeInLocation.
+func (m ASN1Mode) ParseGeneralizedTimeStringInLocation(inp string, loc *time.Location) (time.Time, error) {
+	return parseASN1Time(inp, m, false, loc)
+}
+
+// ParseUTCTime parses an ASN.1 UTCTime, such as `230102150405Z`.
+// Two digit years from 50 to 99 are in the 20th century and years from 00 to 49 are in the 21st century,
+// as required by RFC 5280.
+// If any component is not within the expected range then an *iso8601.RangeError is returned.
+func (m ASN1Mode) ParseUTCTime(inp []byte) (time.Time, error) {
+	return parseASN1Time(inp, m, true, nil)
+}
+
+// ParseUTCTimeString parses an ASN.1 UTCTime string. See ParseUTCTime for the accepted input.
+func (m ASN1Mode) ParseUTCTimeString(inp string) (time.Time, error) {
+	return parseASN1Time(inp, m, true, nil)
+}
+
+// parseASN1Time parses a GeneralizedTime, or a UTCTime if utc is true.
+// A local time without zone information is parsed in local, or returns ErrMissingZone if local is nil.
+func parseASN1Time[T input](inp T, mode ASN1Mode, utc bool, local *time.Location) (time.Time, error) {
+	var f fields
+	var i int
+	var err error
+
+	if utc {
+		if f.year, err = readDigits(inp, i, 2); err != nil {
+			return time.Time{}, err
+		}
+		if f.year < 50 {
+			f.year += 2000
+		} else {
+			f.year += 1900
+		}
+		i += 2
+	} else {
+		if f.year, err = readDigits(inp, i, 4); err != nil {
+			return time.Time{}, err
+		}
+		i += 4
+	}
+
+	// The month, day and hour are always given, followed by the minute and second when they are required.
+	// BER allows the second to be omitted, and the minute too in a GeneralizedTime.
+	required := 5
+	switch {
+	case mode == DER:
+	case utc:
+		required = 4
+	default:
+		required = 3
+	}
+	p := month
+	for _, v := range []*int{&f.month, &f.day, &f.hour, &f.minute, &f.second} {
+		if int(p-month) >= required && (i == len(inp) || !isDigit(inp[i])) {
+			break
+		}
+		if *v, err = readDigits(inp, i, 2); err != nil {
+			return time.Time{}, err
+		}
+		i += 2
+		p++
+	}
+
+	// A fraction applies to the last component given.
+	var fraction int64
+	if !utc && i < len(inp) && (inp[i] == '.' || (inp[i] == ',' && mode == BER)) {
+		if mode == DER && p != millisecond {
+			return time.Time{}, newUnexpectedCharacterError(inp[i])
+		}
+		i++
+		var n int
+		for ; i < len(inp) && isDigit(inp[i]); i++ {
+			if n == 9 {
+				return time.Time{}, ErrPrecision
+			}
+			fraction = fraction*10 + int64(inp[i]-'0')
+			n++
+		}
+		if n == 0 {
+			return time.Time{}, unexpected(inp, i)
+		}
+		if mode == DER && inp[i-1] == '0' {
+			// The canonical fraction has no trailing zeros.
+			return time.Time{}, newUnexpectedCharacterError(inp[i-1])
+		}
+		for ; n < 9; n++ {
+			fraction *= 10
+		}
+		switch p {
+		case minute:
+			fraction *= 3600
+		case second:
+			fraction *= 60
+		}
+	}
+
+	loc := time.UTC
+	switch {
+	case i == len(inp):
+		if mode == DER || utc {
+			return time.Time{}, ErrUnexpectedEnd
+		}
+		if local == nil {
+			return time.Time{}, ErrMissingZone
+		}
+		loc = local
+	case inp[i] == 'Z':
+		if i+1 != len(inp) {
+			return time.Time{}, ErrRemainingData
+		}
+	case mode == DER:
+		return time.Time{}, newUnexpectedCharacterError(inp[i])
+	default:
+		z := inp[i:]
+		for j := 1; j < len(z); j++ {
+			if z[j] == ':' {
+				// Offsets are always in the basic format (`+0100`).
+				return time.Time{}, newUnexpectedCharacterError(z[j])
+			}
+		}
+		if utc && len(z) != 5 {
+			return time.Time{}, ErrZoneCharacters
+		}
+		offset, kind, err := parseISOOffset(z, &ParseOptions{})
+		if err != nil {
+			return time.Time{}, err
+		}
+		loc = zoneLocation(offset, kind, &ParseOptions{})
+	}
+
+	switch {
+	case f.month < 1 || f.month > 12: // Month 1-12
+		return time.Time{}, &RangeError{
+			Value:   string(inp),
+			Element: "month",
+			Given:   f.month,
+			Min:     1,
+			Max:     12,
+		}
+	case f.day < 1 || f.day > daysIn(time.Month(f.month), f.year): // Day 1-daysIn(month, year)
+		return time.Time{}, &RangeError{
+			Value:   string(inp),
+			Element: "day",
+			Given:   f.day,
+			Min:     1,
+			Max:     daysIn(time.Month(f.month), f.year),
+		}
+	case f.hour > 23: // Hour 0-23
+		return time.Time{}, &RangeError{
+			Value:   string(inp),
+			Element: "hour",
+			Given:   f.hour,
+			Min:     0,
+			Max:     23,
+		}
+	case f.minute > 59: // Minute 0-59
+		return time.Time{}, &RangeError{
+			Value:   string(inp),
+			Element: "minute",
+			Given:   f.minute,
+			Min:     0,
+			Max:     59,
+		}
+	case f.second > 59: // Second 0-59
+		return time.Time{}, &RangeError{
+			Value:   string(inp),
+			Element: "second",
+			Given:   f.second,
+			Min:     0,
+			Max:     59,
+		}
+	}
+
+	t := time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, 0, loc)
+	return t.Add(time.Duration(fraction)), nil
+}
+
+// FormatGeneralizedTime returns the DER encoding of t as an ASN.1 GeneralizedTime, such as `20230102150405.5Z`.
+// The time is converted to UTC, and years outside of 0000-9999 return an *iso8601.RangeError.
+func FormatGeneralizedTime(t time.Time) (string, error) {
+	b, err := AppendGeneralizedTime(make([]byte, 0, 25), t)
+	return string(b), err
+}
+
+// AppendGeneralizedTime is like FormatGeneralizedTime but appends the encoding of t to dst and returns the extended buffer.
+func AppendGeneralizedTime(dst []byte, t time.Time) ([]byte, error) {
+	t = t.UTC()
+	if year := t.Year(); year < 0 || year > 9999 {
+		return dst, &RangeError{
+			Value:   t.Format(time.RFC3339Nano),
+			Element: "year",
+			Given:   year,
+			Min:     0,
+			Max:     9999,
+		}
+	}
+	dst = appendInt(dst, t.Year(), 4)
+	dst = appendASN1Clock(dst, t)
+	dst = appendFraction(dst, t.Nanosecond(), PrecisionNanosecond, FormatOptions{})
+	return append(dst, 'Z'), nil
+}
+
+// FormatUTCTime returns the DER encoding of t as an ASN.1 UTCTime, such as `230102150405Z`.
+// The time is converted to UTC and any fraction of a second is dropped.
+// Years outside of 1950-2049, which cannot be represented by a two digit year, return an *iso8601.RangeError.
+func FormatUTCTime(t time.Time) (string, error) {
+	b, err := AppendUTCTime(make([]byte, 0, 13), t)
+	return string(b), err
+}
+
+// AppendUTCTime is like FormatUTCTime but appends the encoding of t to dst and returns the extended buffer.
+func AppendUTCTime(dst []byte, t time.Time) ([]byte, error) {
+	t = t.UTC()
+	if year := t.Year(); year < 1950 || year > 2049 {
+		return dst, &RangeError{
+			Value:   t.Format(time.RFC3339Nano),
+			Element: "year",
+			Given:   year,
+			Min:     1950,
+			Max:     2049,
+		}
+	}
+	dst = appendInt(dst, t.Year()%100, 2)
+	dst = appendASN1Clock(dst, t)
+	return append(dst, 'Z'), nil
+}
+
+// appendASN1Clock appends the month, day, hour, minute and second of t.
+func appendASN1Clock(dst []byte, t time.Time) []byte {
+	_, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	dst = appendInt(dst, int(month), 2)
+	dst = appendInt(dst, day, 2)
+	dst = appendInt(dst, hour, 2)
+	dst = appendInt(dst, min, 2)
+	return appendInt(dst, sec, 2)
+}
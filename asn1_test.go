@@ -0,0 +1,201 @@
+package iso8601
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseGeneralizedTime(t *testing.T) {
+	var cases = []struct {
+		Mode   ASN1Mode
+		Using  string
+		Expect time.Time
+		Err    error
+	}{
+		{Mode: DER, Using: "20230102150405Z", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{Mode: DER, Using: "20230102150405.123Z", Expect: time.Date(2023, 1, 2, 15, 4, 5, 123000000, time.UTC)},
+		{Mode: DER, Using: "20230102150405.120Z", Err: UnexpectedCharacterError{Character: '0'}},
+		{Mode: DER, Using: "20230102150405,1Z", Err: UnexpectedCharacterError{Character: ','}},
+		{Mode: DER, Using: "20230102150405.Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Mode: DER, Using: "20230102150405", Err: ErrUnexpectedEnd},
+		{Mode: DER, Using: "202301021504Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Mode: DER, Using: "20230102150405+0100", Err: UnexpectedCharacterError{Character: '+'}},
+		{Mode: DER, Using: "20230102150405ZZ", Err: ErrRemainingData},
+		{Mode: DER, Using: "20231302150405Z", Err: &RangeError{Element: "month", Given: 13}},
+		{Mode: DER, Using: "20230229150405Z", Err: &RangeError{Element: "day", Given: 29}},
+		{Mode: DER, Using: "20230102150460Z", Err: &RangeError{Element: "second", Given: 60}},
+		{Mode: BER, Using: "20230102150405.0Z", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{Mode: BER, Using: "20230102150405,25Z", Expect: time.Date(2023, 1, 2, 15, 4, 5, 250000000, time.UTC)},
+		{Mode: BER, Using: "20230102150405", Err: ErrMissingZone},
+		{Mode: BER, Using: "20230102150405-0500", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.FixedZone("", -5*3600))},
+		{Mode: BER, Using: "202301021504+01", Expect: time.Date(2023, 1, 2, 15, 4, 0, 0, time.FixedZone("", 3600))},
+		{Mode: BER, Using: "2023010215.5Z", Expect: time.Date(2023, 1, 2, 15, 30, 0, 0, time.UTC)},
+		{Mode: BER, Using: "202301021504.25Z", Expect: time.Date(2023, 1, 2, 15, 4, 15, 0, time.UTC)},
+		{Mode: BER, Using: "20230102150405+01:00", Err: UnexpectedCharacterError{Character: ':'}},
+		{Mode: BER, Using: "20230102150405.1234567891Z", Err: ErrPrecision},
+		{Mode: BER, Using: "2023010215Q", Err: UnexpectedCharacterError{Character: 'Q'}},
+		{Mode: BER, Using: "20230102", Err: ErrUnexpectedEnd},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			d, err := c.Mode.ParseGeneralizedTimeString(c.Using)
+			checkASN1Result(t, d, err, c.Expect, c.Err)
+
+			b, err := c.Mode.ParseGeneralizedTime([]byte(c.Using))
+			checkASN1Result(t, b, err, c.Expect, c.Err)
+		})
+	}
+}
+
+func TestParseGeneralizedTimeInLocation(t *testing.T) {
+	var loc = time.FixedZone("EST", -5*3600)
+	var cases = []struct {
+		Mode   ASN1Mode
+		Using  string
+		Expect time.Time
+		Err    error
+	}{
+		{Mode: BER, Using: "20230102150405", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, loc)},
+		{Mode: BER, Using: "2023010215.5", Expect: time.Date(2023, 1, 2, 15, 30, 0, 0, loc)},
+		{Mode: BER, Using: "20230102150405Z", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{Mode: BER, Using: "20230102150405+0100", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.FixedZone("", 3600))},
+		{Mode: DER, Using: "20230102150405", Err: ErrUnexpectedEnd},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			d, err := c.Mode.ParseGeneralizedTimeStringInLocation(c.Using, loc)
+			checkASN1Result(t, d, err, c.Expect, c.Err)
+
+			b, err := c.Mode.ParseGeneralizedTimeInLocation([]byte(c.Using), loc)
+			checkASN1Result(t, b, err, c.Expect, c.Err)
+		})
+	}
+}
+
+func TestParseUTCTime(t *testing.T) {
+	var cases = []struct {
+		Mode   ASN1Mode
+		Using  string
+		Expect time.Time
+		Err    error
+	}{
+		{Mode: DER, Using: "230102150405Z", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{Mode: DER, Using: "490102150405Z", Expect: time.Date(2049, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{Mode: DER, Using: "500102150405Z", Expect: time.Date(1950, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{Mode: DER, Using: "991231235959Z", Expect: time.Date(1999, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{Mode: DER, Using: "2301021504Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Mode: DER, Using: "230102150405.5Z", Err: UnexpectedCharacterError{Character: '.'}},
+		{Mode: DER, Using: "230102150405", Err: ErrUnexpectedEnd},
+		{Mode: BER, Using: "2301021504Z", Expect: time.Date(2023, 1, 2, 15, 4, 0, 0, time.UTC)},
+		{Mode: BER, Using: "230102150405-0800", Expect: time.Date(2023, 1, 2, 15, 4, 5, 0, time.FixedZone("", -8*3600))},
+		{Mode: BER, Using: "230102150405", Err: ErrUnexpectedEnd},
+		{Mode: BER, Using: "230102150405+01", Err: ErrZoneCharacters},
+		{Mode: BER, Using: "23010215Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			d, err := c.Mode.ParseUTCTimeString(c.Using)
+			checkASN1Result(t, d, err, c.Expect, c.Err)
+
+			b, err := c.Mode.ParseUTCTime([]byte(c.Using))
+			checkASN1Result(t, b, err, c.Expect, c.Err)
+		})
+	}
+}
+
+func checkASN1Result(t *testing.T, d time.Time, err error, expect time.Time, expectErr error) {
+	t.Helper()
+	if want, ok := expectErr.(*RangeError); ok {
+		var rangeErr *RangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Element != want.Element || rangeErr.Given != want.Given {
+			t.Fatalf("expected to return error %v (%T), got %v (%T)", expectErr, expectErr, err, err)
+		}
+		return
+	}
+	if !errors.Is(err, expectErr) {
+		t.Fatalf("expected to return error %v (%T), got %v (%T)", expectErr, expectErr, err, err)
+	}
+	if expectErr != nil {
+		return
+	}
+	if !d.Equal(expect) {
+		t.Errorf("Parse = %s; want %s", d, expect)
+	}
+	if _, offset := d.Zone(); offset != 0 {
+		if _, want := expect.Zone(); offset != want {
+			t.Errorf("offset = %d; want %d", offset, want)
+		}
+	}
+}
+
+func TestFormatGeneralizedTime(t *testing.T) {
+	var cases = []struct {
+		Using  time.Time
+		Expect string
+		Err    bool
+	}{
+		{Using: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC), Expect: "20230102150405Z"},
+		{Using: time.Date(2023, 1, 2, 15, 4, 5, 120000000, time.UTC), Expect: "20230102150405.12Z"},
+		{Using: time.Date(2023, 1, 2, 15, 4, 5, 0, time.FixedZone("", -3600)), Expect: "20230102160405Z"},
+		{Using: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), Err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Expect, func(t *testing.T) {
+			s, err := FormatGeneralizedTime(c.Using)
+			if c.Err {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) {
+					t.Fatalf("expected to return a *RangeError, got %v (%T)", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != c.Expect {
+				t.Errorf("FormatGeneralizedTime = %q; want %q", s, c.Expect)
+			}
+			if d, err := DER.ParseGeneralizedTimeString(s); err != nil || !d.Equal(c.Using) {
+				t.Errorf("DER.ParseGeneralizedTimeString(%q) = %s, %v; want %s", s, d, err, c.Using)
+			}
+		})
+	}
+}
+
+func TestFormatUTCTime(t *testing.T) {
+	var cases = []struct {
+		Using  time.Time
+		Expect string
+		Err    bool
+	}{
+		{Using: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC), Expect: "230102150405Z"},
+		{Using: time.Date(1950, 1, 2, 15, 4, 5, 999999999, time.UTC), Expect: "500102150405Z"},
+		{Using: time.Date(2049, 12, 31, 23, 30, 0, 0, time.FixedZone("", 3600)), Expect: "491231223000Z"},
+		{Using: time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC), Err: true},
+		{Using: time.Date(1949, 12, 31, 23, 59, 59, 0, time.UTC), Err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Expect, func(t *testing.T) {
+			s, err := FormatUTCTime(c.Using)
+			if c.Err {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || rangeErr.Element != "year" {
+					t.Fatalf("expected to return a *RangeError, got %v (%T)", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != c.Expect {
+				t.Errorf("FormatUTCTime = %q; want %q", s, c.Expect)
+			}
+		})
+	}
+}
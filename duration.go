@@ -0,0 +1,619 @@
+package iso8601
+
+import (
+	"math"
+	"time"
+)
+
+// Duration is an ISO8601 duration, such as `P1Y2M3DT4H5M6.5S`.
+//
+// Unlike a time.Duration, the years, months, weeks and days of a Duration are nominal:
+// their length depends on the time they are added to.
+// Components are expected to be non-negative, with the sign of the duration given by Negative.
+type Duration struct {
+	// Negative reverses the direction of the duration, so that it is subtracted when added to a time.
+	Negative bool
+
+	Years   int
+	Months  int
+	Weeks   int
+	Days    int
+	Hours   int
+	Minutes int
+	Seconds int
+	// Nanoseconds is the fraction of a second, between 0 and 999999999.
+	Nanoseconds int
+}
+
+// Components of a duration, in the order they must appear.
+const (
+	durationYears = iota
+	durationMonths
+	durationWeeks
+	durationDays
+	durationHours
+	durationMinutes
+	durationSeconds
+)
+
+// maxDurationDigits is the number of digits accepted for a single component of a duration,
+// so that it cannot overflow an int.
+const maxDurationDigits = 18
+
+// ParseDuration parses an ISO8601 duration.
+// This function expects input that matches:
+//
+//	P1Y2M3DT4H5M6S
+//	P1Y
+//	PT36H
+//	PT0.5S, PT0,5S
+//	P2W
+//
+// Components must be given in order and only the seconds may have a decimal fraction.
+// Weeks cannot be combined with any other component.
+// Use ParseOptions to accept the signed durations (`-P1D`) and combined weeks (`P1W2D`) of ISO 8601-2.
+//
+// The alternative format, which writes a duration like a date and time, is also accepted:
+//
+//	P0001-02-03T04:05:06
+//	P00010203T040506
+//	P0001-034T04:05:06 (years and days)
+//	P0001034T040506 (years and days)
+//
+// If a component of the alternative format exceeds its carry-over point (12 months, 30 days,
+// 365 days when given with the year, 24 hours, 60 minutes or 60 seconds) then an *iso8601.RangeError is returned.
+func ParseDuration(inp []byte) (Duration, error) {
+	return parseDuration(inp, &ParseOptions{})
+}
+
+// ParseDurationString parses an ISO8601 duration string. See ParseDuration for the accepted input.
+func ParseDurationString(inp string) (Duration, error) {
+	return parseDuration(inp, &ParseOptions{})
+}
+
+func parseDuration[T input](inp T, o *ParseOptions) (Duration, error) {
+	var d Duration
+	var i int
+	if o.AllowSignedDuration && len(inp) > 0 && (inp[0] == '+' || inp[0] == '-') {
+		d.Negative = inp[0] == '-'
+		i++
+	}
+	if i == len(inp) {
+		return d, ErrUnexpectedEnd
+	}
+	if inp[i] != 'P' {
+		return d, newUnexpectedCharacterError(inp[i])
+	}
+	i++
+
+	if !o.RejectAlternativeDuration && isAlternativeDuration(inp, i) {
+		alt, err := parseAlternativeDuration(inp, i)
+		alt.Negative = d.Negative
+		return alt, err
+	}
+
+	var (
+		next      = durationYears // the earliest component that may follow
+		timePart  bool            // the time designator `T` was given
+		component bool            // a component was given since the last designator
+	)
+	for i < len(inp) {
+		if inp[i] == 'T' {
+			if timePart {
+				return d, newUnexpectedCharacterError(inp[i])
+			}
+			timePart = true
+			component = false
+			next = durationHours
+			i++
+			continue
+		}
+
+		var v, n int
+		for ; i < len(inp) && isDigit(inp[i]); i++ {
+			v = v*10 + int(inp[i]-'0')
+			n++
+		}
+		if n == 0 {
+			return d, unexpected(inp, i)
+		}
+		if n > maxDurationDigits {
+			return d, ErrDurationOverflow
+		}
+
+		var fraction, nfraction int
+		if i < len(inp) && (inp[i] == '.' || inp[i] == ',') {
+			if o.RejectDurationFraction {
+				return d, newUnexpectedCharacterError(inp[i])
+			}
+			for i++; i < len(inp) && isDigit(inp[i]); i++ {
+				fraction = fraction*10 + int(inp[i]-'0')
+				nfraction++
+				if nfraction > 9 {
+					return d, ErrPrecision
+				}
+			}
+			if nfraction == 0 {
+				return d, unexpected(inp, i)
+			}
+			for j := nfraction; j < 9; j++ {
+				fraction *= 10
+			}
+		}
+		if i == len(inp) {
+			return d, ErrUnexpectedEnd
+		}
+
+		c := durationComponent(inp[i], timePart)
+		if c < next {
+			return d, newUnexpectedCharacterError(inp[i])
+		}
+		if (nfraction > 0 && c != durationSeconds) || (c == durationWeeks && o.RejectDurationWeeks) {
+			return d, newUnexpectedCharacterError(inp[i])
+		}
+
+		switch c {
+		case durationYears:
+			d.Years = v
+		case durationMonths:
+			d.Months = v
+		case durationWeeks:
+			d.Weeks = v
+		case durationDays:
+			d.Days = v
+		case durationHours:
+			d.Hours = v
+		case durationMinutes:
+			d.Minutes = v
+		case durationSeconds:
+			d.Seconds = v
+			d.Nanoseconds = fraction
+		}
+		next = c + 1
+		component = true
+		i++
+	}
+
+	// Either no components were given at all (`P`), or the time designator was not followed by any (`P1DT`).
+	if !component {
+		return d, ErrUnexpectedEnd
+	}
+	if d.Weeks != 0 && !o.AllowCombinedWeeks && d != (Duration{Negative: d.Negative, Weeks: d.Weeks}) {
+		return d, ErrDurationWeeks
+	}
+	return d, nil
+}
+
+// durationComponent returns the component named by the designator c,
+// or -1 if c is not a designator in the date or time part of a duration.
+func durationComponent(c byte, timePart bool) int {
+	switch {
+	case !timePart && c == 'Y':
+		return durationYears
+	case !timePart && c == 'M':
+		return durationMonths
+	case !timePart && c == 'W':
+		return durationWeeks
+	case !timePart && c == 'D':
+		return durationDays
+	case timePart && c == 'H':
+		return durationHours
+	case timePart && c == 'M':
+		return durationMinutes
+	case timePart && c == 'S':
+		return durationSeconds
+	}
+	return -1
+}
+
+// Neg returns d with the opposite sign.
+func (d Duration) Neg() Duration {
+	d.Negative = !d.Negative
+	return d
+}
+
+// Abs returns d with a positive sign.
+func (d Duration) Abs() Duration {
+	d.Negative = false
+	return d
+}
+
+// IsZero reports whether d has no non-zero components.
+func (d Duration) IsZero() bool {
+	d.Negative = false
+	return d == Duration{}
+}
+
+// String returns the ISO8601 representation of d, such as `P1Y2M3DT4H5M6.5S`.
+// Components that are zero are omitted, and a zero duration is represented as `PT0S`.
+// A negative duration is written with a leading minus sign (`-P1D`) as defined by ISO 8601-2,
+// which is only accepted by a parser with ParseOptions.AllowSignedDuration set.
+func (d Duration) String() string {
+	return string(d.AppendFormat(make([]byte, 0, 32)))
+}
+
+// AppendFormat is like String but appends the representation of d to dst and returns the extended buffer.
+func (d Duration) AppendFormat(dst []byte) []byte {
+	if d.IsZero() {
+		return append(dst, "PT0S"...)
+	}
+	if d.Negative {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, 'P')
+
+	dst = appendDurationComponent(dst, d.Years, 'Y')
+	dst = appendDurationComponent(dst, d.Months, 'M')
+	dst = appendDurationComponent(dst, d.Weeks, 'W')
+	dst = appendDurationComponent(dst, d.Days, 'D')
+	if d.Hours == 0 && d.Minutes == 0 && d.Seconds == 0 && d.Nanoseconds == 0 {
+		return dst
+	}
+
+	dst = append(dst, 'T')
+	dst = appendDurationComponent(dst, d.Hours, 'H')
+	dst = appendDurationComponent(dst, d.Minutes, 'M')
+	if d.Seconds != 0 || d.Nanoseconds != 0 {
+		dst = appendInt(dst, d.Seconds, 1)
+		dst = appendFraction(dst, d.Nanoseconds, PrecisionNanosecond, FormatOptions{})
+		dst = append(dst, 'S')
+	}
+	return dst
+}
+
+func appendDurationComponent(dst []byte, v int, designator byte) []byte {
+	if v == 0 {
+		return dst
+	}
+	dst = appendInt(dst, v, 1)
+	return append(dst, designator)
+}
+
+// The length of a year and month used by ApproxStd.
+const (
+	// AverageYear is the average length of a year in the Gregorian calendar (365.2425 days).
+	AverageYear = 31556952 * time.Second
+	// AverageMonth is the average length of a month in the Gregorian calendar (one twelfth of AverageYear).
+	AverageMonth = AverageYear / 12
+)
+
+// Normalize returns d with each time component carried into the next larger component,
+// so that `PT90M` becomes `PT1H30M`, and with months carried into years, so that `P14M` becomes `P1Y2M`.
+// Hours are not carried into days and days are not carried into weeks or months,
+// as the length of a day depends on the time it is added to.
+func (d Duration) Normalize() Duration {
+	d.Seconds += d.Nanoseconds / int(time.Second)
+	d.Nanoseconds %= int(time.Second)
+	d.Minutes += d.Seconds / 60
+	d.Seconds %= 60
+	d.Hours += d.Minutes / 60
+	d.Minutes %= 60
+	d.Years += d.Months / 12
+	d.Months %= 12
+	return d
+}
+
+// Std converts d to a time.Duration.
+//
+// A day is taken to be exactly 24 hours and a week exactly 7 days.
+// Years and months have no fixed length, so if d has any then ErrCalendarDuration is returned;
+// use ApproxStd to convert them using an average length instead.
+// ErrDurationOverflow is returned if d cannot be represented by a time.Duration.
+func (d Duration) Std() (time.Duration, error) {
+	if d.Years != 0 || d.Months != 0 {
+		return 0, ErrCalendarDuration
+	}
+	return d.std(0)
+}
+
+// ApproxStd converts d to a time.Duration like Std,
+// but converts years and months using their average length in the Gregorian calendar (AverageYear and AverageMonth).
+func (d Duration) ApproxStd() (time.Duration, error) {
+	return d.std(d.Years*12 + d.Months)
+}
+
+// std converts d to a time.Duration, with the years and months of d given as a number of average months.
+func (d Duration) std(months int) (time.Duration, error) {
+	var total time.Duration
+	for _, c := range [...]struct {
+		n    int
+		unit time.Duration
+	}{
+		{months, AverageMonth},
+		{d.Weeks, 7 * 24 * time.Hour},
+		{d.Days, 24 * time.Hour},
+		{d.Hours, time.Hour},
+		{d.Minutes, time.Minute},
+		{d.Seconds, time.Second},
+		{d.Nanoseconds, 1},
+	} {
+		if c.n == 0 {
+			continue
+		}
+		if c.n < 0 || int64(c.n) > int64(math.MaxInt64/c.unit) {
+			return 0, ErrDurationOverflow
+		}
+		v := time.Duration(c.n) * c.unit
+		if total > math.MaxInt64-v {
+			return 0, ErrDurationOverflow
+		}
+		total += v
+	}
+	if d.Negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// Compare compares d with o, returning -1 if d is shorter than o, 0 if they are the same length and +1 if d is longer.
+// A negative duration is shorter than any positive duration.
+//
+// As with Std, a day is taken to be exactly 24 hours and a week exactly 7 days, so `P1D` is equal to `PT24H`.
+// Years and months have no fixed length, so ErrCalendarDuration is returned unless d and o have
+// the same total number of months (twelve months are equal to one year).
+// Convert both durations with ApproxStd to compare them using an average length instead.
+func (d Duration) Compare(o Duration) (int, error) {
+	// Durations of different signs are ordered without comparing their months.
+	if ds, os := d.sign(), o.sign(); ds != os {
+		if ds < os {
+			return -1, nil
+		}
+		return 1, nil
+	}
+
+	dm, om := d.Years*12+d.Months, o.Years*12+o.Months
+	if d.Negative {
+		dm = -dm
+	}
+	if o.Negative {
+		om = -om
+	}
+	if dm != om {
+		return 0, ErrCalendarDuration
+	}
+
+	// The months are equal, so only the remaining exact components need to be compared.
+	ds, dn := d.exact()
+	os, on := o.exact()
+	switch {
+	case ds < os || (ds == os && dn < on):
+		return -1, nil
+	case ds > os || (ds == os && dn > on):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// sign returns -1 if d is negative, 0 if it is zero and +1 if it is positive.
+func (d Duration) sign() int {
+	switch {
+	case d.IsZero():
+		return 0
+	case d.Negative:
+		return -1
+	}
+	return 1
+}
+
+// exact returns the signed length of d excluding years and months, as seconds and nanoseconds.
+func (d Duration) exact() (int64, int64) {
+	s := (int64(d.Weeks)*7+int64(d.Days))*86400 + int64(d.Hours)*3600 + int64(d.Minutes)*60 + int64(d.Seconds)
+	n := int64(d.Nanoseconds)
+	s, n = s+n/1e9, n%1e9
+	if d.Negative {
+		return -s, -n
+	}
+	return s, n
+}
+
+// DurationFromStd returns the Duration equal to d, in hours, minutes and seconds.
+// Its String method returns the canonical ISO8601 representation of d, such as `PT1H30M` or `-PT0.5S`.
+// A negative duration is only parsed back with ParseOptions.AllowSignedDuration set.
+func DurationFromStd(d time.Duration) Duration {
+	var r Duration
+	// Use an unsigned magnitude, as the negation of the minimum time.Duration overflows.
+	u := uint64(d)
+	if d < 0 {
+		r.Negative = true
+		u = -u
+	}
+	r.Hours = int(u / uint64(time.Hour))
+	r.Minutes = int(u % uint64(time.Hour) / uint64(time.Minute))
+	r.Seconds = int(u % uint64(time.Minute) / uint64(time.Second))
+	r.Nanoseconds = int(u % uint64(time.Second))
+	return r
+}
+
+// MonthEndPolicy selects how adding years or months to a time resolves a day of the month
+// that does not exist in the resulting month, such as adding one month to the 31st of January.
+type MonthEndPolicy uint8
+
+const (
+	// MonthEndClamp uses the last day of the resulting month (2020-01-31 plus one month is 2020-02-29).
+	MonthEndClamp MonthEndPolicy = iota
+	// MonthEndOverflow carries the excess days into the following month, like time.Time.AddDate
+	// (2020-01-31 plus one month is 2020-03-02).
+	MonthEndOverflow
+	// MonthEndReject returns ErrMonthEnd.
+	MonthEndReject
+)
+
+// AddTo returns t plus d, resolving the end of the month with MonthEndClamp.
+// See AddToPolicy for how the components of d are applied.
+// If d is too large to be added to a time then the zero time is returned.
+func (d Duration) AddTo(t time.Time) time.Time {
+	t, _ = addDuration(t, d, 1, MonthEndClamp)
+	return t
+}
+
+// SubFrom returns t minus d, resolving the end of the month with MonthEndClamp.
+// If d is too large to be subtracted from a time then the zero time is returned.
+func (d Duration) SubFrom(t time.Time) time.Time {
+	t, _ = addDuration(t, d, -1, MonthEndClamp)
+	return t
+}
+
+// AddToPolicy returns t plus d, resolving the end of the month with the given policy.
+//
+// The components of d are applied in order of significance.
+// Years and months are added together as a number of months to the date of t,
+// and weeks and days are then added to the date, keeping the same wall clock time in the location of t.
+// Finally, hours, minutes and seconds are added as elapsed time,
+// so that adding `PT24H` across a daylight saving time transition differs from adding `P1D`.
+// If a component of d is too large to be added to a time then ErrDurationOverflow is returned.
+func (d Duration) AddToPolicy(t time.Time, policy MonthEndPolicy) (time.Time, error) {
+	return addDuration(t, d, 1, policy)
+}
+
+// SubFromPolicy returns t minus d, resolving the end of the month with the given policy.
+// The components of d are subtracted in the same order that AddToPolicy adds them.
+func (d Duration) SubFromPolicy(t time.Time, policy MonthEndPolicy) (time.Time, error) {
+	return addDuration(t, d, -1, policy)
+}
+
+// addDuration adds d multiplied by sign (1 or -1) to t.
+func addDuration(t time.Time, d Duration, sign int, policy MonthEndPolicy) (time.Time, error) {
+	if d.Negative {
+		sign = -sign
+	}
+	months, ok := calendarUnits(d.Years, 12, d.Months, maxCalendarMonths)
+	if !ok {
+		return time.Time{}, ErrDurationOverflow
+	}
+	days, ok := calendarUnits(d.Weeks, 7, d.Days, maxCalendarDays)
+	if !ok {
+		return time.Time{}, ErrDurationOverflow
+	}
+	elapsed, err := Duration{Hours: d.Hours, Minutes: d.Minutes, Seconds: d.Seconds, Nanoseconds: d.Nanoseconds}.std(0)
+	if err != nil {
+		return time.Time{}, err
+	}
+	months, days = sign*months, sign*days
+
+	// Only rebuild the time from its wall clock if the date changes,
+	// so that a time within a daylight saving overlap keeps its offset.
+	if months != 0 || days != 0 {
+		year, month, day := t.Date()
+		if months != 0 {
+			m := int(month) - 1 + months
+			year += floorDiv(m, 12)
+			month = time.Month(m - floorDiv(m, 12)*12 + 1)
+
+			if max := daysIn(month, year); day > max {
+				switch policy {
+				case MonthEndClamp:
+					day = max
+				case MonthEndReject:
+					return time.Time{}, ErrMonthEnd
+				}
+			}
+		}
+		hour, min, sec := t.Clock()
+		t = time.Date(year, month, day+days, hour, min, sec, t.Nanosecond(), t.Location())
+	}
+
+	return t.Add(time.Duration(sign) * elapsed), nil
+}
+
+// maxCalendarMonths and maxCalendarDays bound the months and days added to a time,
+// so that their length in seconds fits in an int64.
+const (
+	maxCalendarMonths = math.MaxInt64 / (31 * 86400)
+	maxCalendarDays   = math.MaxInt64 / 86400
+)
+
+// calendarUnits returns n multiplied by per plus rest, such as the months in a number of years and months.
+// It returns false if the result is beyond max or cannot be represented by an int.
+func calendarUnits(n, per, rest int, max int64) (int, bool) {
+	if max > math.MaxInt {
+		max = math.MaxInt
+	}
+	a, b := int64(n), int64(rest)
+	if a < -max/int64(per) || a > max/int64(per) || b < -max || b > max {
+		return 0, false
+	}
+	v := a*int64(per) + b
+	if v < -max || v > max {
+		return 0, false
+	}
+	return int(v), true
+}
+
+// floorDiv returns x divided by y rounded towards negative infinity.
+func floorDiv(x, y int) int {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+// Between returns the duration from a to b, split into years, months, days, hours, minutes and seconds,
+// such that adding it to a with AddTo returns b. If b is before a, a negative duration is returned,
+// which is only parsed back from its String with ParseOptions.AllowSignedDuration set.
+//
+// The duration is calculated in the location of a.
+// The largest possible number of months is taken first, then days, with the remainder as elapsed time.
+// Months that start at the end of a month are resolved with MonthEndClamp, in the same way as AddTo,
+// so the duration from 2020-01-31 to 2020-02-29 is one month.
+func Between(a, b time.Time) Duration {
+	b = b.In(a.Location())
+	sign := 1
+	if b.Before(a) {
+		sign = -1
+	}
+
+	// passes reports whether adding the calendar components of d to a moves beyond b.
+	// Components that overflow when added to a are taken to move beyond b.
+	passes := func(d Duration) (time.Time, bool) {
+		t, err := addDuration(a, d, sign, MonthEndClamp)
+		if err != nil {
+			return t, true
+		}
+		if sign > 0 {
+			return t, t.After(b)
+		}
+		return t, t.Before(b)
+	}
+
+	ay, am, _ := a.Date()
+	by, bm, _ := b.Date()
+	d := Duration{Months: sign * ((by-ay)*12 + int(bm) - int(am))}
+	for {
+		if _, ok := passes(d); !ok {
+			break
+		}
+		d.Months--
+	}
+
+	t, _ := addDuration(a, d, sign, MonthEndClamp)
+	d.Days = sign * civilDays(t, b)
+	for {
+		var ok bool
+		if t, ok = passes(d); !ok {
+			break
+		}
+		d.Days--
+	}
+
+	elapsed := b.Sub(t)
+	if sign < 0 {
+		elapsed = -elapsed
+	}
+	d.Negative = sign < 0
+	d.Years, d.Months = d.Months/12, d.Months%12
+	d.Hours = int(elapsed / time.Hour)
+	d.Minutes = int(elapsed % time.Hour / time.Minute)
+	d.Seconds = int(elapsed % time.Minute / time.Second)
+	d.Nanoseconds = int(elapsed % time.Second)
+	return d
+}
+
+// civilDays returns the number of calendar days from the date of a to the date of b, ignoring their time of day.
+func civilDays(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	from := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	to := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from) / (24 * time.Hour))
+}
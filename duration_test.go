@@ -0,0 +1,493 @@
+package iso8601
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+var durationCases = []struct {
+	Using  string
+	Expect Duration
+	String string
+}{
+	{Using: "P1Y2M3DT4H5M6S", Expect: Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+	{Using: "P1Y", Expect: Duration{Years: 1}},
+	{Using: "P1M", Expect: Duration{Months: 1}},
+	{Using: "PT1M", Expect: Duration{Minutes: 1}},
+	{Using: "P2W", Expect: Duration{Weeks: 2}},
+	{Using: "PT36H", Expect: Duration{Hours: 36}},
+	{Using: "PT0.5S", Expect: Duration{Nanoseconds: 500000000}},
+	{Using: "PT0,5S", Expect: Duration{Nanoseconds: 500000000}, String: "PT0.5S"},
+	{Using: "PT1.000000001S", Expect: Duration{Seconds: 1, Nanoseconds: 1}},
+	{Using: "P0D", Expect: Duration{}, String: "PT0S"},
+	{Using: "PT0S", Expect: Duration{}},
+	{Using: "P1DT12H", Expect: Duration{Days: 1, Hours: 12}},
+	{Using: "P10000Y", Expect: Duration{Years: 10000}},
+}
+
+func TestParseDuration(t *testing.T) {
+	for _, tc := range durationCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			d, err := ParseDurationString(tc.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tc.Expect {
+				t.Errorf("ParseDuration = %+v; want %+v", d, tc.Expect)
+			}
+
+			want := tc.String
+			if want == "" {
+				want = tc.Using
+			}
+			if s := d.String(); s != want {
+				t.Errorf("String = %q; want %q", s, want)
+			}
+
+			b, err := ParseDuration([]byte(tc.Using))
+			if err != nil || b != d {
+				t.Errorf("ParseDuration([]byte) = %+v, %v; want %+v", b, err, d)
+			}
+		})
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	var invalidCases = []struct {
+		Using  string
+		Expect error
+	}{
+		{Using: "", Expect: ErrUnexpectedEnd},
+		{Using: "P", Expect: ErrUnexpectedEnd},
+		{Using: "PT", Expect: ErrUnexpectedEnd},
+		{Using: "P1DT", Expect: ErrUnexpectedEnd},
+		{Using: "P1", Expect: ErrUnexpectedEnd},
+		{Using: "1D", Expect: UnexpectedCharacterError{Character: '1'}},
+		{Using: "P1H", Expect: UnexpectedCharacterError{Character: 'H'}},
+		{Using: "PT1D", Expect: UnexpectedCharacterError{Character: 'D'}},
+		{Using: "P1M1Y", Expect: UnexpectedCharacterError{Character: 'Y'}},
+		{Using: "P1Y1Y", Expect: UnexpectedCharacterError{Character: 'Y'}},
+		{Using: "P1DT1H1H", Expect: UnexpectedCharacterError{Character: 'H'}},
+		{Using: "P1DTT1H", Expect: UnexpectedCharacterError{Character: 'T'}},
+		{Using: "P0.5Y", Expect: UnexpectedCharacterError{Character: 'Y'}},
+		{Using: "PT1.S", Expect: UnexpectedCharacterError{Character: 'S'}},
+		{Using: "PT.5S", Expect: UnexpectedCharacterError{Character: '.'}},
+		{Using: "PT0.1234567891S", Expect: ErrPrecision},
+		{Using: "P1W2D", Expect: ErrDurationWeeks},
+		{Using: "P1234567890123456789Y", Expect: ErrDurationOverflow},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			if _, err := ParseDurationString(tc.Using); !errors.Is(err, tc.Expect) {
+				t.Errorf("ParseDuration expected to return error %v (%T), got %v (%T)", tc.Expect, tc.Expect, err, err)
+			}
+		})
+	}
+}
+
+func TestDurationAddTo(t *testing.T) {
+	jan31 := time.Date(2020, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	var addCases = []struct {
+		Duration string
+		From     time.Time
+		Policy   MonthEndPolicy
+		Expect   time.Time
+		Err      error
+	}{
+		{Duration: "P1M", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2020, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{Duration: "P1M", From: jan31, Policy: MonthEndOverflow, Expect: time.Date(2020, 3, 2, 10, 0, 0, 0, time.UTC)},
+		{Duration: "P1M", From: jan31, Policy: MonthEndReject, Err: ErrMonthEnd},
+		{Duration: "P2M", From: jan31, Policy: MonthEndReject, Expect: time.Date(2020, 3, 31, 10, 0, 0, 0, time.UTC)},
+		{Duration: "P1Y", From: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Policy: MonthEndClamp, Expect: time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{Duration: "P1Y1M", From: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Policy: MonthEndClamp, Expect: time.Date(2021, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{Duration: "P11M", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2020, 12, 31, 10, 0, 0, 0, time.UTC)},
+		{Duration: "P13M", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2021, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{Duration: "P1M1D", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Duration: "P2W", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2020, 2, 14, 10, 0, 0, 0, time.UTC)},
+		{Duration: "PT14H0.5S", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2020, 2, 1, 0, 0, 0, 500000000, time.UTC)},
+		{Duration: "P1DT1H", From: jan31, Policy: MonthEndClamp, Expect: time.Date(2020, 2, 1, 11, 0, 0, 0, time.UTC)},
+		{Duration: "PT999999999999999999H", From: jan31, Policy: MonthEndClamp, Err: ErrDurationOverflow},
+		{Duration: "P999999999999999999Y", From: jan31, Policy: MonthEndClamp, Err: ErrDurationOverflow},
+		{Duration: "P999999999999999999W", From: jan31, Policy: MonthEndClamp, Err: ErrDurationOverflow},
+	}
+
+	for _, tc := range addCases {
+		t.Run(tc.Duration, func(t *testing.T) {
+			d, err := ParseDurationString(tc.Duration)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := d.AddToPolicy(tc.From, tc.Policy)
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("AddToPolicy expected to return error %v, got %v", tc.Err, err)
+			}
+			if tc.Err != nil {
+				return
+			}
+			if !got.Equal(tc.Expect) {
+				t.Errorf("AddToPolicy = %s; want %s", got, tc.Expect)
+			}
+			if tc.Policy == MonthEndClamp {
+				if got := d.AddTo(tc.From); !got.Equal(tc.Expect) {
+					t.Errorf("AddTo = %s; want %s", got, tc.Expect)
+				}
+			}
+		})
+	}
+}
+
+func TestDurationSubFrom(t *testing.T) {
+	mar31 := time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)
+	d := Duration{Months: 1}
+	if got := d.SubFrom(mar31); !got.Equal(time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("SubFrom = %s; want 2020-02-29", got)
+	}
+	if _, err := d.SubFromPolicy(mar31, MonthEndReject); !errors.Is(err, ErrMonthEnd) {
+		t.Errorf("SubFromPolicy expected to return error %v, got %v", ErrMonthEnd, err)
+	}
+	if _, err := (Duration{Hours: math.MaxInt}).SubFromPolicy(mar31, MonthEndClamp); !errors.Is(err, ErrDurationOverflow) {
+		t.Errorf("SubFromPolicy expected to return error %v, got %v", ErrDurationOverflow, err)
+	}
+	d = Duration{Years: 1, Days: 1, Hours: 1}
+	if got := d.SubFrom(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)); !got.Equal(time.Date(2018, 12, 30, 23, 0, 0, 0, time.UTC)) {
+		t.Errorf("SubFrom = %s; want 2018-12-30T23:00:00Z", got)
+	}
+}
+
+func TestDurationAddToDST(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// The clocks go forward by one hour at 01:00 on the 28th of March 2021.
+	from := time.Date(2021, 3, 27, 12, 0, 0, 0, london)
+	if got := (Duration{Days: 1}).AddTo(from); !got.Equal(time.Date(2021, 3, 28, 12, 0, 0, 0, london)) || got.Hour() != 12 {
+		t.Errorf("P1D = %s; want 2021-03-28T12:00:00+01:00", got)
+	}
+	if got := (Duration{Hours: 24}).AddTo(from); got.Hour() != 13 {
+		t.Errorf("PT24H = %s; want 2021-03-28T13:00:00+01:00", got)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	var betweenCases = []struct {
+		From, To time.Time
+		Expect   string
+	}{
+		{From: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2021, 3, 4, 4, 0, 0, 0, time.UTC), Expect: "P1Y2M3DT4H"},
+		{From: time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Expect: "P1M"},
+		{From: time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Expect: "P1M1D"},
+		{From: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), To: time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC), Expect: "P1Y"},
+		{From: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), To: time.Date(2020, 1, 2, 11, 59, 59, 500000000, time.UTC), Expect: "PT23H59M59.5S"},
+		{From: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Expect: "PT0S"},
+		{From: time.Date(2021, 3, 4, 4, 0, 0, 0, time.UTC), To: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Expect: "-P1Y2M3DT4H"},
+		{From: time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), Expect: "-P1M"},
+		{From: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2020, 1, 1, 2, 0, 0, 0, time.FixedZone("", 3600)), Expect: "PT1H"},
+	}
+
+	for _, tc := range betweenCases {
+		t.Run(tc.Expect, func(t *testing.T) {
+			d := Between(tc.From, tc.To)
+			if s := d.String(); s != tc.Expect {
+				t.Errorf("Between = %s; want %s", s, tc.Expect)
+			}
+			if got := d.AddTo(tc.From); !got.Equal(tc.To) {
+				t.Errorf("AddTo = %s; want %s", got, tc.To)
+			}
+		})
+	}
+}
+
+func TestBetweenRoundTrip(t *testing.T) {
+	locations := []*time.Location{time.UTC, time.FixedZone("", -9*3600-30*60)}
+	if london, err := time.LoadLocation("Europe/London"); err == nil {
+		locations = append(locations, london)
+	}
+
+	for _, loc := range locations {
+		start := time.Date(2019, 12, 28, 1, 30, 0, 0, loc)
+		for i := 0; i < 500; i++ {
+			a := start.Add(time.Duration(i) * 37 * time.Hour)
+			for j := 0; j < 500; j += 7 {
+				b := start.Add(time.Duration(j)*53*time.Hour + time.Duration(j)*time.Minute)
+				d := Between(a, b)
+				if got := d.AddTo(a); !got.Equal(b) {
+					t.Fatalf("Between(%s, %s) = %s; AddTo = %s", a, b, d, got)
+				}
+				if d.Days > 31 || d.Hours > 25 || d.Months > 11 {
+					t.Fatalf("Between(%s, %s) = %s is not split into components", a, b, d)
+				}
+			}
+		}
+	}
+}
+
+func TestNegativeDuration(t *testing.T) {
+	d := Duration{Negative: true, Days: 1, Hours: 2}
+	if s := d.String(); s != "-P1DT2H" {
+		t.Errorf("String = %q; want %q", s, "-P1DT2H")
+	}
+	from := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := d.AddTo(from); !got.Equal(time.Date(2020, 2, 28, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("AddTo = %s; want 2020-02-28T22:00:00Z", got)
+	}
+	if got := d.SubFrom(from); !got.Equal(time.Date(2020, 3, 2, 2, 0, 0, 0, time.UTC)) {
+		t.Errorf("SubFrom = %s; want 2020-03-02T02:00:00Z", got)
+	}
+	if s := (Duration{Negative: true}).String(); s != "PT0S" {
+		t.Errorf("String = %q; want %q", s, "PT0S")
+	}
+}
+
+func TestDurationNormalize(t *testing.T) {
+	var normalizeCases = []struct {
+		Using, Expect string
+	}{
+		{Using: "PT90M", Expect: "PT1H30M"},
+		{Using: "PT3600S", Expect: "PT1H"},
+		{Using: "PT36H", Expect: "PT36H"},
+		{Using: "P14M", Expect: "P1Y2M"},
+		{Using: "P40D", Expect: "P40D"},
+		{Using: "P1Y13MT61M61.5S", Expect: "P2Y1MT1H2M1.5S"},
+	}
+
+	for _, tc := range normalizeCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			d, err := ParseDurationString(tc.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := d.Normalize().String(); s != tc.Expect {
+				t.Errorf("Normalize = %s; want %s", s, tc.Expect)
+			}
+		})
+	}
+}
+
+func TestDurationStd(t *testing.T) {
+	var stdCases = []struct {
+		Using  string
+		Expect time.Duration
+		Err    error
+	}{
+		{Using: "PT1H30M", Expect: 90 * time.Minute},
+		{Using: "PT0.5S", Expect: 500 * time.Millisecond},
+		{Using: "P1D", Expect: 24 * time.Hour},
+		{Using: "P2W", Expect: 14 * 24 * time.Hour},
+		{Using: "P1DT1S", Expect: 24*time.Hour + time.Second},
+		{Using: "PT2562047H", Expect: 2562047 * time.Hour},
+		{Using: "PT2562048H", Err: ErrDurationOverflow},
+		{Using: "P1M", Err: ErrCalendarDuration},
+		{Using: "P1Y", Err: ErrCalendarDuration},
+	}
+
+	for _, tc := range stdCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			d, err := ParseDurationString(tc.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			std, err := d.Std()
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("Std expected to return error %v, got %v", tc.Err, err)
+			}
+			if std != tc.Expect {
+				t.Errorf("Std = %s; want %s", std, tc.Expect)
+			}
+		})
+	}
+
+	if std, err := (Duration{Negative: true, Minutes: 1}).Std(); err != nil || std != -time.Minute {
+		t.Errorf("Std = %s, %v; want -1m0s", std, err)
+	}
+}
+
+func TestDurationApproxStd(t *testing.T) {
+	d := Duration{Years: 1, Months: 6, Days: 1}
+	std, err := d.ApproxStd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := AverageYear + 6*AverageMonth + 24*time.Hour; std != want {
+		t.Errorf("ApproxStd = %s; want %s", std, want)
+	}
+	if AverageYear != time.Duration(365.2425*24*float64(time.Hour)) {
+		t.Errorf("AverageYear = %s", AverageYear)
+	}
+	if _, err := (Duration{Years: 300}).ApproxStd(); !errors.Is(err, ErrDurationOverflow) {
+		t.Errorf("ApproxStd expected to return error %v, got %v", ErrDurationOverflow, err)
+	}
+}
+
+func TestDurationCompare(t *testing.T) {
+	var compareCases = []struct {
+		A, B   Duration
+		Expect int
+		Err    error
+	}{
+		{A: Duration{Days: 1}, B: Duration{Hours: 24}, Expect: 0},
+		{A: Duration{Weeks: 1}, B: Duration{Days: 7}, Expect: 0},
+		{A: Duration{Minutes: 90}, B: Duration{Hours: 1, Minutes: 30}, Expect: 0},
+		{A: Duration{Days: 1}, B: Duration{Hours: 23}, Expect: 1},
+		{A: Duration{Seconds: 1}, B: Duration{Seconds: 1, Nanoseconds: 1}, Expect: -1},
+		{A: Duration{Years: 1}, B: Duration{Months: 12}, Expect: 0},
+		{A: Duration{Months: 1, Days: 1}, B: Duration{Months: 1}, Expect: 1},
+		{A: Duration{Negative: true, Days: 1}, B: Duration{Hours: 1}, Expect: -1},
+		{A: Duration{Negative: true, Days: 1}, B: Duration{Negative: true, Hours: 1}, Expect: -1},
+		{A: Duration{Months: 1}, B: Duration{Days: 30}, Err: ErrCalendarDuration},
+		{A: Duration{Months: 1}, B: Duration{Negative: true, Months: 1}, Expect: 1},
+		{A: Duration{Negative: true, Years: 1}, B: Duration{Days: 1}, Expect: -1},
+		{A: Duration{Months: 1}, B: Duration{}, Expect: 1},
+		{A: Duration{Negative: true, Months: 1}, B: Duration{Negative: true, Months: 2}, Err: ErrCalendarDuration},
+	}
+
+	for _, tc := range compareCases {
+		t.Run(tc.A.String()+" "+tc.B.String(), func(t *testing.T) {
+			c, err := tc.A.Compare(tc.B)
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("Compare expected to return error %v, got %v", tc.Err, err)
+			}
+			if c != tc.Expect {
+				t.Errorf("Compare = %d; want %d", c, tc.Expect)
+			}
+			if tc.Err != nil {
+				return
+			}
+			if c, _ := tc.B.Compare(tc.A); c != -tc.Expect {
+				t.Errorf("Reverse Compare = %d; want %d", c, -tc.Expect)
+			}
+		})
+	}
+}
+
+func TestDurationFromStd(t *testing.T) {
+	var fromCases = []struct {
+		Using  time.Duration
+		Expect string
+	}{
+		{Using: 0, Expect: "PT0S"},
+		{Using: 90 * time.Minute, Expect: "PT1H30M"},
+		{Using: 36 * time.Hour, Expect: "PT36H"},
+		{Using: 1500 * time.Millisecond, Expect: "PT1.5S"},
+		{Using: time.Nanosecond, Expect: "PT0.000000001S"},
+		{Using: -30 * time.Second, Expect: "-PT30S"},
+		{Using: math.MinInt64, Expect: "-PT2562047H47M16.854775808S"},
+	}
+
+	for _, tc := range fromCases {
+		t.Run(tc.Expect, func(t *testing.T) {
+			d := DurationFromStd(tc.Using)
+			if s := d.String(); s != tc.Expect {
+				t.Errorf("String = %s; want %s", s, tc.Expect)
+			}
+			// A negative duration is only parsed back with AllowSignedDuration.
+			p, err := ParseOptions{AllowSignedDuration: true}.ParseDurationString(d.String())
+			if err != nil || p != d {
+				t.Errorf("ParseDurationString(%s) = %+v, %v; want %+v", d, p, err, d)
+			}
+			if _, err := ParseDurationString(d.String()); tc.Using < 0 && !errors.Is(err, UnexpectedCharacterError{Character: '-'}) {
+				t.Errorf("ParseDurationString(%s) expected to return error %v, got %v", d, UnexpectedCharacterError{Character: '-'}, err)
+			}
+			if tc.Using == math.MinInt64 {
+				return
+			}
+			if std, err := d.Std(); err != nil || std != tc.Using {
+				t.Errorf("Std = %s, %v; want %s", std, err, tc.Using)
+			}
+		})
+	}
+}
+
+func TestParseSignedDuration(t *testing.T) {
+	opts := ParseOptions{AllowSignedDuration: true, AllowCombinedWeeks: true}
+
+	var signedCases = []struct {
+		Using  string
+		Expect Duration
+		String string
+	}{
+		{Using: "-P1D", Expect: Duration{Negative: true, Days: 1}},
+		{Using: "+P1D", Expect: Duration{Days: 1}, String: "P1D"},
+		{Using: "-PT0.5S", Expect: Duration{Negative: true, Nanoseconds: 500000000}},
+		{Using: "P1W2D", Expect: Duration{Weeks: 1, Days: 2}},
+		{Using: "-P1Y2W3DT4H", Expect: Duration{Negative: true, Years: 1, Weeks: 2, Days: 3, Hours: 4}},
+		{Using: "-P2W", Expect: Duration{Negative: true, Weeks: 2}},
+		{Using: "-P0001-02-03", Expect: Duration{Negative: true, Years: 1, Months: 2, Days: 3}, String: "-P1Y2M3D"},
+	}
+
+	for _, tc := range signedCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			d, err := opts.ParseDurationString(tc.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tc.Expect {
+				t.Errorf("ParseDuration = %+v; want %+v", d, tc.Expect)
+			}
+			want := tc.String
+			if want == "" {
+				want = tc.Using
+			}
+			if s := d.String(); s != want {
+				t.Errorf("String = %q; want %q", s, want)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		Using   string
+		Options ParseOptions
+		Expect  error
+	}{
+		{Using: "-P1D", Expect: UnexpectedCharacterError{Character: '-'}},
+		{Using: "P1W2D", Expect: ErrDurationWeeks},
+		{Using: "-P1W2D", Options: ParseOptions{AllowSignedDuration: true}, Expect: ErrDurationWeeks},
+		{Using: "-", Options: opts, Expect: ErrUnexpectedEnd},
+		{Using: "--P1D", Options: opts, Expect: UnexpectedCharacterError{Character: '-'}},
+		{Using: "P-1D", Options: opts, Expect: UnexpectedCharacterError{Character: '-'}},
+	} {
+		if _, err := tc.Options.ParseDurationString(tc.Using); !errors.Is(err, tc.Expect) {
+			t.Errorf("%s: expected error %v, got %v", tc.Using, tc.Expect, err)
+		}
+	}
+}
+
+func TestSignedDurationArithmetic(t *testing.T) {
+	opts := ParseOptions{AllowSignedDuration: true}
+	from := time.Date(2020, 3, 31, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2019, 1, 15, 6, 30, 0, 0, time.UTC)
+
+	d, err := opts.ParseDurationString(Between(from, to).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Negative {
+		t.Errorf("Between(%s, %s) = %s; want a negative duration", from, to, d)
+	}
+	if got := d.AddTo(from); !got.Equal(to) {
+		t.Errorf("AddTo = %s; want %s", got, to)
+	}
+	if got := d.Neg().SubFrom(from); !got.Equal(to) {
+		t.Errorf("Neg().SubFrom = %s; want %s", got, to)
+	}
+	if got := d.Abs().SubFrom(from); !got.Equal(to) {
+		t.Errorf("Abs().SubFrom = %s; want %s", got, to)
+	}
+
+	a, _ := opts.ParseDurationString("-P1D")
+	b, _ := opts.ParseDurationString("-PT24H")
+	if c, err := a.Compare(b); err != nil || c != 0 {
+		t.Errorf("Compare(%s, %s) = %d, %v; want 0", a, b, c, err)
+	}
+	if c, err := a.Compare(b.Neg()); err != nil || c != -1 {
+		t.Errorf("Compare(%s, %s) = %d, %v; want -1", a, b.Neg(), c, err)
+	}
+	if std, err := a.Std(); err != nil || std != -24*time.Hour {
+		t.Errorf("Std(%s) = %s, %v; want -24h", a, std, err)
+	}
+}
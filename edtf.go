@@ -0,0 +1,1019 @@
+package iso8601
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = EDTF{}
+	_ json.Unmarshaler         = &EDTF{}
+	_ encoding.TextMarshaler   = EDTF{}
+	_ encoding.TextUnmarshaler = &EDTF{}
+)
+
+// Qualifier is the qualification of an Extended Date/Time Format (EDTF) date.
+type Qualifier uint8
+
+const (
+	// Uncertain marks a date whose source is questionable, written with `?` (`1984?`).
+	Uncertain Qualifier = 1 << iota
+	// Approximate marks a date that is an estimate, written with `~` (`1984~`).
+	Approximate
+	// UncertainApproximate marks a date that is both uncertain and approximate, written with `%` (`1984%`).
+	UncertainApproximate = Uncertain | Approximate
+)
+
+// IntervalEnd describes the start or end of an EDTF interval.
+type IntervalEnd uint8
+
+const (
+	// EndDate is an end given by a date.
+	EndDate IntervalEnd = iota
+	// EndOpen is an open end written as `..`, which extends indefinitely.
+	EndOpen
+	// EndUnknown is an unknown end written as an empty string.
+	EndUnknown
+)
+
+// SetKind selects how the members of an EDTF set are combined.
+type SetKind uint8
+
+const (
+	// NoSet is an expression that is not a set.
+	NoSet SetKind = iota
+	// OneOf is a set of which exactly one member is the date, written in brackets (`[1667,1668,1670..1672]`).
+	OneOf
+	// AllOf is a set of which every member is included, written in braces (`{1960,1961-12}`).
+	AllOf
+)
+
+// maxEDTFYear is the largest magnitude of a year in an EDTF date.
+// It is further limited to the range of an int on 32-bit platforms.
+const maxEDTFYear int64 = 99999999999
+
+// EDTFDate is a date or date-time in the Extended Date/Time Format (EDTF) defined by the Library of Congress,
+// and as part of ISO 8601-2.
+type EDTFDate struct {
+	// Year is the year, which may be negative or have more than four digits (`Y170000002`).
+	Year int
+	// Month is the month from 1 to 12, or a season or other sub-year grouping from 21 to 41 as defined by ISO 8601-2.
+	// The codes 21 (spring) to 24 (winter) and 25 to 28 are the seasons of the northern hemisphere,
+	// 29 to 32 the seasons of the southern hemisphere, 33 to 36 the quarters, 37 to 39 the quadrimesters
+	// and 40 to 41 the semesters of the year.
+	Month int
+	// Day is the day of the month.
+	Day int
+
+	// Hour, Minute and Second are the time of day of a date-time.
+	Hour, Minute, Second int
+	// Location is the zone of a date-time, or nil if it was not given.
+	Location *time.Location
+
+	// Precision is PrecisionYear, PrecisionMonth or PrecisionDay for a date, and PrecisionSecond for a date-time.
+	Precision Precision
+
+	// Qualifier qualifies the date as a whole.
+	Qualifier Qualifier
+	// YearQualifier, MonthQualifier and DayQualifier qualify individual components of the date (`2004-?06-11`).
+	YearQualifier, MonthQualifier, DayQualifier Qualifier
+
+	// SignificantDigits is the number of significant digits of the year (`1950S2`), or 0 if all digits are significant.
+	SignificantDigits int
+	// Exponent is the exponent of a year given in exponential form (`Y-17E7`), which is Year divided by 10 to the Exponent.
+	Exponent int
+
+	// UnspecifiedYear, UnspecifiedMonth and UnspecifiedDay mark the digits of each component given as `X` (`201X`),
+	// with bit 0 set for the last digit, bit 1 for the digit before it and so on.
+	// The unspecified digits are zero in Year, Month and Day.
+	UnspecifiedYear, UnspecifiedMonth, UnspecifiedDay uint8
+}
+
+// EDTF is an Extended Date/Time Format expression, which is a single date, an interval between two dates,
+// or a set of dates and ranges.
+type EDTF struct {
+	// Start is the date of a single date expression, or the start of an interval.
+	Start EDTFDate
+	// End is the end of an interval.
+	End EDTFDate
+
+	// Interval reports whether the expression is an interval.
+	Interval bool
+	// StartKind and EndKind describe the ends of an interval.
+	StartKind, EndKind IntervalEnd
+
+	// Set is the kind of a set expression.
+	Set SetKind
+	// Members are the members of a set, each of which is a single date or a range between two dates (`1670..1672`).
+	Members []EDTF
+}
+
+// ParseEDTF parses an Extended Date/Time Format expression at level 0, 1 or 2.
+// This function expects input that matches:
+//
+//	1985-04-12
+//	1985-04-12T23:20:30Z
+//	1984?, 2004-06~, 2004-06-11% (uncertain and approximate dates)
+//	201X, 1985-04-XX (unspecified digits)
+//	Y170000002, Y-170000002 (years with more than four digits)
+//	2001-21 (seasons 21 to 24)
+//	1964/2008, 1985-04-12/.., /1985 (intervals, with open or unknown ends)
+//	2004-?06-11, ?2004-06~-11 (qualified components)
+//	[1667,1668,1670..1672], [..1760-12-03], {1960,1961-12} (sets)
+//	1950S2, Y171010000S3 (significant digits)
+//	Y-17E7 (exponential years)
+//	2001-33 (sub-year groupings 25 to 41)
+//
+// If any component is not within the expected range then an *iso8601.RangeError is returned.
+func ParseEDTF(inp []byte) (EDTF, error) {
+	return parseEDTF(inp)
+}
+
+// ParseEDTFString parses an Extended Date/Time Format expression string. See ParseEDTF for the accepted input.
+func ParseEDTFString(inp string) (EDTF, error) {
+	return parseEDTF(inp)
+}
+
+func parseEDTF[T input](inp T) (EDTF, error) {
+	if len(inp) > 0 && (inp[0] == '[' || inp[0] == '{') {
+		return parseEDTFSet(inp)
+	}
+
+	var e EDTF
+	slash := -1
+	for i := 0; i < len(inp); i++ {
+		if inp[i] == '/' {
+			if slash >= 0 {
+				return EDTF{}, newUnexpectedCharacterError(inp[i])
+			}
+			slash = i
+		}
+	}
+
+	var err error
+	if slash < 0 {
+		e.Start, err = parseEDTFDate(inp)
+		return e, err
+	}
+
+	e.Interval = true
+	if e.StartKind, e.Start, err = parseEDTFEnd(inp[:slash]); err != nil {
+		return EDTF{}, err
+	}
+	if e.EndKind, e.End, err = parseEDTFEnd(inp[slash+1:]); err != nil {
+		return EDTF{}, err
+	}
+	switch {
+	case e.StartKind != EndDate && e.EndKind != EndDate:
+		// At least one end of an interval is a date.
+		return EDTF{}, newUnexpectedCharacterError('/')
+	case e.StartKind == EndDate && e.EndKind == EndDate && e.Start.Earliest().After(e.End.Latest()):
+		return EDTF{}, ErrIntervalOrder
+	}
+	return e, nil
+}
+
+// parseEDTFSet parses a set of dates and ranges.
+func parseEDTFSet[T input](inp T) (EDTF, error) {
+	e := EDTF{Set: OneOf}
+	closing := byte(']')
+	if inp[0] == '{' {
+		e.Set = AllOf
+		closing = '}'
+	}
+	if inp[len(inp)-1] != closing || len(inp) < 3 {
+		return EDTF{}, unexpected(inp, len(inp))
+	}
+
+	start := 1
+	for i := 1; i < len(inp); i++ {
+		if inp[i] != ',' && i != len(inp)-1 {
+			continue
+		}
+		m, err := parseEDTFMember(inp[start:i])
+		if err != nil {
+			return EDTF{}, err
+		}
+		e.Members = append(e.Members, m)
+		start = i + 1
+	}
+
+	// Only the first member may be open at its start (`[..1760-12-03]`), and only the last at its end (`[1760-12..]`).
+	for i, m := range e.Members {
+		if (i > 0 && m.StartKind == EndOpen) || (i < len(e.Members)-1 && m.EndKind == EndOpen) {
+			return EDTF{}, newUnexpectedCharacterError('.')
+		}
+	}
+	return e, nil
+}
+
+// parseEDTFMember parses a date, or a range between two dates, within a set.
+func parseEDTFMember[T input](inp T) (EDTF, error) {
+	var e EDTF
+	dots := -1
+	for i := 0; i+1 < len(inp); i++ {
+		if inp[i] == '.' && inp[i+1] == '.' {
+			dots = i
+			break
+		}
+	}
+
+	var err error
+	if dots < 0 {
+		e.Start, err = parseEDTFDate(inp)
+		return e, err
+	}
+
+	e.Interval = true
+	if dots == 0 {
+		e.StartKind = EndOpen
+	} else if e.Start, err = parseEDTFDate(inp[:dots]); err != nil {
+		return EDTF{}, err
+	}
+	if dots+2 == len(inp) {
+		e.EndKind = EndOpen
+	} else if e.End, err = parseEDTFDate(inp[dots+2:]); err != nil {
+		return EDTF{}, err
+	}
+	switch {
+	case e.StartKind == EndOpen && e.EndKind == EndOpen:
+		return EDTF{}, newUnexpectedCharacterError('.')
+	case e.StartKind == EndDate && e.EndKind == EndDate && e.Start.Earliest().After(e.End.Latest()):
+		return EDTF{}, ErrIntervalOrder
+	}
+	return e, nil
+}
+
+// parseEDTFEnd parses the start or end of an interval.
+func parseEDTFEnd[T input](inp T) (IntervalEnd, EDTFDate, error) {
+	switch {
+	case len(inp) == 0:
+		return EndUnknown, EDTFDate{}, nil
+	case len(inp) == 2 && inp[0] == '.' && inp[1] == '.':
+		return EndOpen, EDTFDate{}, nil
+	}
+	d, err := parseEDTFDate(inp)
+	return EndDate, d, err
+}
+
+// edtfTimeOptions parses the date-time of an EDTF date with the scanner.
+var edtfTimeOptions = ParseOptions{
+	Strict:               true,
+	RejectSpaceSeparator: true,
+	AllowNegativeYear:    true,
+	Precisions:           Precisions(PrecisionSecond),
+}
+
+func parseEDTFDate[T input](inp T) (EDTFDate, error) {
+	var d EDTFDate
+	if n := len(inp); n > 0 {
+		switch inp[n-1] {
+		case '?':
+			d.Qualifier = Uncertain
+		case '~':
+			d.Qualifier = Approximate
+		case '%':
+			d.Qualifier = UncertainApproximate
+		}
+		if d.Qualifier != 0 {
+			inp = inp[:n-1]
+		}
+		if d.Qualifier != 0 && n > 1 {
+			if q, _ := readQualifier(inp, n-2); q != 0 {
+				// The date as a whole has a single qualifier.
+				return EDTFDate{}, newUnexpectedCharacterError(inp[n-2])
+			}
+		}
+	}
+
+	for i := 0; i < len(inp); i++ {
+		if inp[i] != 'T' {
+			continue
+		}
+		if d.Qualifier != 0 {
+			// A date-time cannot be qualified.
+			return EDTFDate{}, newUnexpectedCharacterError(inp[i])
+		}
+		// The year of a date-time has four digits, as a longer year must be written with `Y`.
+		j := 0
+		if inp[0] == '-' {
+			j++
+		}
+		if j+4 < i && isDigit(inp[j+4]) {
+			return EDTFDate{}, newUnexpectedCharacterError(inp[j+4])
+		}
+		var f fields
+		if err := scan(inp, &edtfTimeOptions, &f); err != nil {
+			return EDTFDate{}, err
+		}
+		d.Year, d.Month, d.Day = f.year, f.month, f.day
+		d.Hour, d.Minute, d.Second = f.hour, f.minute, f.second
+		if f.zone != ZoneAbsent {
+			d.Location = zoneLocation(f.offset, f.zone, &edtfTimeOptions)
+		}
+		d.Precision = PrecisionSecond
+		return d, nil
+	}
+
+	var i int
+	var q Qualifier
+	var err error
+	d.YearQualifier, i = readQualifier(inp, 0)
+	if i < len(inp) && inp[i] == 'Y' {
+		if i, err = parseEDTFLongYear(inp, i, &d); err != nil {
+			return EDTFDate{}, err
+		}
+	} else {
+		neg := i < len(inp) && inp[i] == '-'
+		if neg {
+			i++
+		}
+		if d.Year, d.UnspecifiedYear, err = readEDTFDigits(inp, i, 4); err != nil {
+			return EDTFDate{}, err
+		}
+		if neg {
+			d.Year = -d.Year
+		}
+		i += 4
+	}
+	d.Precision = PrecisionYear
+
+	if i < len(inp) && inp[i] == 'S' && d.UnspecifiedYear == 0 {
+		if i, err = parseSignificantDigits(inp, i, &d); err != nil {
+			return EDTFDate{}, err
+		}
+		if i < len(inp) {
+			return EDTFDate{}, newUnexpectedCharacterError(inp[i])
+		}
+		return d, nil
+	}
+
+	// A qualifier before a component qualifies it alone,
+	// and a qualifier after a component qualifies it along with the components before it.
+	q, i = readQualifier(inp, i)
+	d.YearQualifier |= q
+
+	if i < len(inp) && d.Exponent == 0 && d.Year >= -9999 && d.Year <= 9999 {
+		if inp[i] != '-' {
+			return EDTFDate{}, newUnexpectedCharacterError(inp[i])
+		}
+		d.MonthQualifier, i = readQualifier(inp, i+1)
+		if d.Month, d.UnspecifiedMonth, err = readEDTFDigits(inp, i, 2); err != nil {
+			return EDTFDate{}, err
+		}
+		q, i = readQualifier(inp, i+2)
+		d.YearQualifier |= q
+		d.MonthQualifier |= q
+		d.Precision = PrecisionMonth
+	}
+	if i < len(inp) && d.Precision == PrecisionMonth {
+		if inp[i] != '-' || d.isSeason() {
+			return EDTFDate{}, newUnexpectedCharacterError(inp[i])
+		}
+		d.DayQualifier, i = readQualifier(inp, i+1)
+		if d.Day, d.UnspecifiedDay, err = readEDTFDigits(inp, i, 2); err != nil {
+			return EDTFDate{}, err
+		}
+		i += 2
+		d.Precision = PrecisionDay
+	}
+	if i < len(inp) {
+		return EDTFDate{}, newUnexpectedCharacterError(inp[i])
+	}
+
+	if err := d.check(string(inp)); err != nil {
+		return EDTFDate{}, err
+	}
+	return d, nil
+}
+
+// readQualifier reads an optional qualifier at position i of inp, and returns the position after it.
+func readQualifier[T input](inp T, i int) (Qualifier, int) {
+	if i < len(inp) {
+		switch inp[i] {
+		case '?':
+			return Uncertain, i + 1
+		case '~':
+			return Approximate, i + 1
+		case '%':
+			return UncertainApproximate, i + 1
+		}
+	}
+	return 0, i
+}
+
+// parseEDTFLongYear parses a year prefixed with `Y` at position i of inp into d, which is either
+// a year with more than four digits (`Y170000002`) or in exponential form (`Y-17E7`).
+// It returns the position after the year.
+func parseEDTFLongYear[T input](inp T, i int, d *EDTFDate) (int, error) {
+	i++
+	neg := i < len(inp) && inp[i] == '-'
+	if neg {
+		i++
+	}
+	// The year is read as an int64, so that it can be compared with maxEDTFYear on 32-bit platforms.
+	var year int64
+	start := i
+	for ; i < len(inp) && isDigit(inp[i]); i++ {
+		if year <= maxEDTFYear {
+			year = year*10 + int64(inp[i]-'0')
+		}
+	}
+	if i == start {
+		return 0, unexpected(inp, i)
+	}
+
+	min := 10000
+	if i < len(inp) && inp[i] == 'E' {
+		i++
+		start = i
+		for ; i < len(inp) && isDigit(inp[i]) && d.Exponent <= 11; i++ {
+			d.Exponent = d.Exponent*10 + int(inp[i]-'0')
+		}
+		if i == start {
+			return 0, unexpected(inp, i)
+		}
+		if d.Exponent == 0 {
+			return 0, newUnexpectedCharacterError(inp[i-1])
+		}
+		for j := 0; j < d.Exponent && year <= maxEDTFYear; j++ {
+			year *= 10
+		}
+		min = 1
+	}
+	max := maxEDTFYear
+	if max > math.MaxInt {
+		max = math.MaxInt
+	}
+	if year < int64(min) || year > max {
+		given := math.MaxInt
+		if year <= math.MaxInt {
+			given = int(year)
+		}
+		// Years prefixed with `Y` have more than four digits.
+		return 0, &RangeError{
+			Value:   string(inp),
+			Element: "year",
+			Given:   given,
+			Min:     min,
+			Max:     int(max),
+		}
+	}
+	d.Year = int(year)
+	if neg {
+		d.Year = -d.Year
+	}
+	return i, nil
+}
+
+// parseSignificantDigits parses the number of significant digits of the year of d at position i of inp,
+// which starts with `S`, and returns the position after it.
+func parseSignificantDigits[T input](inp T, i int, d *EDTFDate) (int, error) {
+	i++
+	start := i
+	for ; i < len(inp) && isDigit(inp[i]) && d.SignificantDigits <= 12; i++ {
+		d.SignificantDigits = d.SignificantDigits*10 + int(inp[i]-'0')
+	}
+	if i == start {
+		return 0, unexpected(inp, i)
+	}
+	if max := yearDigits(d.Year); d.SignificantDigits < 1 || d.SignificantDigits > max {
+		return 0, &RangeError{
+			Value:   string(inp),
+			Element: "significant digits",
+			Given:   d.SignificantDigits,
+			Min:     1,
+			Max:     max,
+		}
+	}
+	return i, nil
+}
+
+// yearDigits returns the number of digits of a year, which is at least four.
+func yearDigits(year int) int {
+	n := 4
+	for v := absInt(year); v >= pow10(n); n++ {
+	}
+	return n
+}
+
+// readEDTFDigits reads n digits starting at position i of inp, which may be given as `X`.
+// It returns the value with the unspecified digits as zero, and a mask of the unspecified digits.
+func readEDTFDigits[T input](inp T, i, n int) (int, uint8, error) {
+	var v int
+	var mask uint8
+	for j := i; j < i+n; j++ {
+		if j >= len(inp) {
+			return 0, 0, ErrUnexpectedEnd
+		}
+		mask <<= 1
+		switch {
+		case isDigit(inp[j]):
+			v = v*10 + int(inp[j]-'0')
+		case inp[j] == 'X':
+			v *= 10
+			mask |= 1
+		default:
+			return 0, 0, newUnexpectedCharacterError(inp[j])
+		}
+	}
+	return v, mask, nil
+}
+
+// check validates the month and day of a date parsed from inp.
+func (d EDTFDate) check(inp string) error {
+	if d.Precision > PrecisionMonth || (d.Precision == PrecisionMonth && d.UnspecifiedMonth == 0 && d.isSeason()) {
+		return nil
+	}
+	if _, _, _, ok := d.civil(false, true); !ok {
+		return &RangeError{
+			Value:   inp,
+			Element: "month",
+			Given:   d.Month,
+			Min:     1,
+			Max:     12,
+		}
+	}
+	if d.Precision != PrecisionDay {
+		return nil
+	}
+	if _, _, _, ok := d.civil(false, false); !ok {
+		return &RangeError{
+			Value:   inp,
+			Element: "day",
+			Given:   d.Day,
+			Min:     1,
+			Max:     d.maxDay(),
+		}
+	}
+	return nil
+}
+
+// maxDay returns the largest number of days in any month that d may denote with its unspecified digits.
+func (d EDTFDate) maxDay() int {
+	lo, hi := d.yearRange()
+	var max int
+	for y := lo; y <= hi; y++ {
+		if d.UnspecifiedYear != 0 && !edtfMatch(absInt(y), absInt(d.Year), d.UnspecifiedYear, 4) {
+			continue
+		}
+		for m := 1; m <= 12; m++ {
+			if days := daysIn(time.Month(m), y); days > max && edtfMatch(m, d.Month, d.UnspecifiedMonth, 2) {
+				max = days
+			}
+		}
+		if max == 31 || isLeap(y) {
+			// Only February differs between years, so a leap year has the most days.
+			break
+		}
+	}
+	return max
+}
+
+// isSeason reports whether the month of d is a season.
+func (d EDTFDate) isSeason() bool {
+	_, _, ok := seasonMonths(d.Month)
+	return ok
+}
+
+// seasonMonths returns the first month and number of months of the season or sub-year grouping with the given code.
+// The seasons are meteorological, so that northern winter starts in December and ends in February of the next year,
+// and southern spring starts in September.
+func seasonMonths(code int) (time.Month, int, bool) {
+	switch code {
+	case 21:
+		return time.March, 3, true
+	case 22:
+		return time.June, 3, true
+	case 23:
+		return time.September, 3, true
+	case 24:
+		return time.December, 3, true
+	case 25, 26, 27, 28:
+		// Northern hemisphere spring, summer, autumn and winter.
+		return seasonMonths(code - 4)
+	case 29, 30, 31, 32:
+		// Southern hemisphere spring, summer, autumn and winter.
+		return seasonMonths(21 + (code-29+2)%4)
+	case 33, 34, 35, 36:
+		// Quarters.
+		return time.Month(1 + 3*(code-33)), 3, true
+	case 37, 38, 39:
+		// Quadrimesters.
+		return time.Month(1 + 4*(code-37)), 4, true
+	case 40, 41:
+		// Semesters.
+		return time.Month(1 + 6*(code-40)), 6, true
+	}
+	return 0, 0, false
+}
+
+// civil returns the earliest, or the latest if last is true, year, month and day that the date may denote
+// with its unspecified digits. If monthOnly is true then the day is ignored.
+// It returns false if no valid date matches.
+func (d EDTFDate) civil(last, monthOnly bool) (year, month, day int, ok bool) {
+	lo, hi := d.yearRange()
+	y, step := lo, 1
+	if last {
+		y, step = hi, -1
+	}
+	for ; y >= lo && y <= hi; y += step {
+		if d.UnspecifiedYear != 0 && !edtfMatch(absInt(y), absInt(d.Year), d.UnspecifiedYear, 4) {
+			continue
+		}
+		if d.Precision == PrecisionYear || d.isSeason() {
+			return y, 0, 0, true
+		}
+		for k := 1; k <= 12; k++ {
+			m := k
+			if last {
+				m = 13 - k
+			}
+			if !edtfMatch(m, d.Month, d.UnspecifiedMonth, 2) {
+				continue
+			}
+			if monthOnly || d.Precision == PrecisionMonth {
+				return y, m, 0, true
+			}
+			days := daysIn(time.Month(m), y)
+			for j := 1; j <= days; j++ {
+				day := j
+				if last {
+					day = days + 1 - j
+				}
+				if edtfMatch(day, d.Day, d.UnspecifiedDay, 2) {
+					return y, m, day, true
+				}
+			}
+		}
+	}
+	return 0, 0, 0, false
+}
+
+// yearRange returns the smallest and largest year that d may denote with its unspecified or significant digits.
+func (d EDTFDate) yearRange() (int, int) {
+	switch {
+	case d.UnspecifiedYear != 0:
+		return edtfRange(d.Year, d.UnspecifiedYear)
+	case d.SignificantDigits > 0:
+		unit := pow10(yearDigits(d.Year) - d.SignificantDigits)
+		lo := absInt(d.Year) / unit * unit
+		hi := lo + unit - 1
+		if d.Year < 0 {
+			return -hi, -lo
+		}
+		return lo, hi
+	}
+	return d.Year, d.Year
+}
+
+// edtfRange returns the smallest and largest year matching a year with unspecified digits.
+func edtfRange(year int, mask uint8) (int, int) {
+	var spread int
+	for i := 0; i < 4; i++ {
+		if mask&(1<<i) != 0 {
+			spread += 9 * pow10(i)
+		}
+	}
+	if year < 0 {
+		return year - spread, year
+	}
+	return year, year + spread
+}
+
+// edtfMatch reports whether v agrees with the specified digits of a component given with width digits,
+// where mask marks its unspecified digits.
+func edtfMatch(v, given int, mask uint8, width int) bool {
+	if v >= pow10(width) {
+		return false
+	}
+	for i := 0; i < width; i++ {
+		if mask&(1<<i) == 0 && v%10 != given%10 {
+			return false
+		}
+		v /= 10
+		given /= 10
+	}
+	return true
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// location returns the location of the bounds of d, which is UTC unless d is a date-time with a zone.
+func (d EDTFDate) location() *time.Location {
+	if d.Location != nil {
+		return d.Location
+	}
+	return time.UTC
+}
+
+// Earliest returns the start of the earliest day, month or year that d may denote, in UTC unless d is a date-time with a zone.
+// A season starts on the 1st of its first month.
+func (d EDTFDate) Earliest() time.Time {
+	loc := d.location()
+	if d.Precision == PrecisionSecond {
+		return time.Date(d.Year, time.Month(d.Month), d.Day, d.Hour, d.Minute, d.Second, 0, loc)
+	}
+	y, m, day, _ := d.civil(false, false)
+	switch {
+	case d.Precision == PrecisionYear:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+	case d.isSeason():
+		start, _, _ := seasonMonths(d.Month)
+		return time.Date(y, start, 1, 0, 0, 0, 0, loc)
+	case d.Precision == PrecisionMonth:
+		return time.Date(y, time.Month(m), 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(y, time.Month(m), day, 0, 0, 0, 0, loc)
+}
+
+// Latest returns the last instant of the latest day, month or year that d may denote, in UTC unless d is a date-time with a zone.
+func (d EDTFDate) Latest() time.Time {
+	loc := d.location()
+	if d.Precision == PrecisionSecond {
+		return time.Date(d.Year, time.Month(d.Month), d.Day, d.Hour, d.Minute, d.Second, 999999999, loc)
+	}
+	y, m, day, _ := d.civil(true, false)
+	var next time.Time
+	switch {
+	case d.Precision == PrecisionYear:
+		next = time.Date(y+1, time.January, 1, 0, 0, 0, 0, loc)
+	case d.isSeason():
+		start, n, _ := seasonMonths(d.Month)
+		next = time.Date(y, start+time.Month(n), 1, 0, 0, 0, 0, loc)
+	case d.Precision == PrecisionMonth:
+		next = time.Date(y, time.Month(m)+1, 1, 0, 0, 0, 0, loc)
+	default:
+		next = time.Date(y, time.Month(m), day+1, 0, 0, 0, 0, loc)
+	}
+	return next.Add(-time.Nanosecond)
+}
+
+// String returns d in the Extended Date/Time Format.
+func (d EDTFDate) String() string {
+	return string(d.AppendFormat(make([]byte, 0, 16)))
+}
+
+// AppendFormat appends d in the Extended Date/Time Format to dst and returns the extended buffer.
+func (d EDTFDate) AppendFormat(dst []byte) []byte {
+	if d.Precision == PrecisionSecond {
+		opts := FormatOptions{Precision: PrecisionSecond}
+		if d.Location == nil {
+			opts.Zone = ZoneFormatOmit
+		}
+		t := time.Date(d.Year, time.Month(d.Month), d.Day, d.Hour, d.Minute, d.Second, 0, d.location())
+		return AppendFormat(dst, t, opts)
+	}
+
+	dst = appendQualifier(dst, d.YearQualifier)
+	year := absInt(d.Year)
+	switch {
+	case d.Exponent > 0:
+		dst = append(dst, 'Y')
+		if d.Year < 0 {
+			dst = append(dst, '-')
+		}
+		dst = appendInt(dst, year/pow10(d.Exponent), 1)
+		dst = append(dst, 'E')
+		dst = appendInt(dst, d.Exponent, 1)
+	case year > 9999 && d.UnspecifiedYear == 0:
+		dst = append(dst, 'Y')
+		if d.Year < 0 {
+			dst = append(dst, '-')
+		}
+		dst = appendInt(dst, year, 1)
+	default:
+		if d.Year < 0 {
+			dst = append(dst, '-')
+		}
+		dst = appendEDTFDigits(dst, year, 4, d.UnspecifiedYear)
+	}
+	if d.SignificantDigits > 0 {
+		dst = append(dst, 'S')
+		dst = appendInt(dst, d.SignificantDigits, 1)
+	}
+	if d.Precision <= PrecisionMonth {
+		dst = append(dst, '-')
+		dst = appendQualifier(dst, d.MonthQualifier)
+		dst = appendEDTFDigits(dst, d.Month, 2, d.UnspecifiedMonth)
+	}
+	if d.Precision <= PrecisionDay {
+		dst = append(dst, '-')
+		dst = appendQualifier(dst, d.DayQualifier)
+		dst = appendEDTFDigits(dst, d.Day, 2, d.UnspecifiedDay)
+	}
+	return appendQualifier(dst, d.Qualifier)
+}
+
+// appendEDTFDigits appends v padded to width digits, with the digits marked by mask written as `X`.
+func appendEDTFDigits(dst []byte, v int, width int, mask uint8) []byte {
+	dst = appendInt(dst, v, width)
+	for i := 0; i < width; i++ {
+		if mask&(1<<i) != 0 {
+			dst[len(dst)-1-i] = 'X'
+		}
+	}
+	return dst
+}
+
+// appendQualifier appends the character of the qualifier q.
+func appendQualifier(dst []byte, q Qualifier) []byte {
+	switch q {
+	case Uncertain:
+		return append(dst, '?')
+	case Approximate:
+		return append(dst, '~')
+	case UncertainApproximate:
+		return append(dst, '%')
+	}
+	return dst
+}
+
+// Earliest returns the earliest time denoted by e, which is the start of a single date or interval,
+// or the earliest start of the members of a set.
+// It returns false if the start of the interval, or of a member of a set, is open or unknown.
+func (e EDTF) Earliest() (time.Time, bool) {
+	if e.Set != NoSet {
+		var earliest time.Time
+		for i, m := range e.Members {
+			t, ok := m.Earliest()
+			if !ok {
+				return time.Time{}, false
+			}
+			if i == 0 || t.Before(earliest) {
+				earliest = t
+			}
+		}
+		return earliest, len(e.Members) > 0
+	}
+	if e.Interval && e.StartKind != EndDate {
+		return time.Time{}, false
+	}
+	return e.Start.Earliest(), true
+}
+
+// Latest returns the latest time denoted by e, which is the end of a single date or interval,
+// or the latest end of the members of a set.
+// It returns false if the end of the interval, or of a member of a set, is open or unknown.
+func (e EDTF) Latest() (time.Time, bool) {
+	if e.Set != NoSet {
+		var latest time.Time
+		for i, m := range e.Members {
+			t, ok := m.Latest()
+			if !ok {
+				return time.Time{}, false
+			}
+			if i == 0 || t.After(latest) {
+				latest = t
+			}
+		}
+		return latest, len(e.Members) > 0
+	}
+	if !e.Interval {
+		return e.Start.Latest(), true
+	}
+	if e.EndKind != EndDate {
+		return time.Time{}, false
+	}
+	return e.End.Latest(), true
+}
+
+// Enumerate calls fn with each date that e may denote in turn, until fn returns false.
+// A single date is enumerated as itself, and a set as each of its members.
+// An interval, or a range within a set, is enumerated as each year, month or day from its start to its end,
+// stepped by the precision of its start.
+// If an interval or range has an open or unknown end, or its start is a date-time, a season,
+// or has unspecified or significant digits, then ErrNotEnumerable is returned.
+func (e EDTF) Enumerate(fn func(EDTFDate) bool) error {
+	_, err := e.enumerate(fn)
+	return err
+}
+
+func (e EDTF) enumerate(fn func(EDTFDate) bool) (bool, error) {
+	if e.Set != NoSet {
+		for _, m := range e.Members {
+			if more, err := m.enumerate(fn); !more || err != nil {
+				return more, err
+			}
+		}
+		return true, nil
+	}
+	if !e.Interval {
+		return fn(e.Start), nil
+	}
+
+	s := e.Start
+	if e.StartKind != EndDate || e.EndKind != EndDate || s.Precision < PrecisionDay || s.isSeason() ||
+		s.UnspecifiedYear != 0 || s.UnspecifiedMonth != 0 || s.UnspecifiedDay != 0 || s.SignificantDigits != 0 {
+		return false, ErrNotEnumerable
+	}
+	end := e.End.Latest()
+	d := EDTFDate{Year: s.Year, Month: s.Month, Day: s.Day, Precision: s.Precision}
+	for !d.Earliest().After(end) {
+		if !fn(d) {
+			return false, nil
+		}
+		switch d.Precision {
+		case PrecisionYear:
+			d.Year++
+		case PrecisionMonth:
+			t := time.Date(d.Year, time.Month(d.Month)+1, 1, 0, 0, 0, 0, time.UTC)
+			d.Year, d.Month = t.Year(), int(t.Month())
+		default:
+			t := time.Date(d.Year, time.Month(d.Month), d.Day+1, 0, 0, 0, 0, time.UTC)
+			d.Year, d.Month, d.Day = t.Year(), int(t.Month()), t.Day()
+		}
+	}
+	return true, nil
+}
+
+// String returns e in the Extended Date/Time Format.
+func (e EDTF) String() string {
+	return string(e.AppendFormat(make([]byte, 0, 32)))
+}
+
+// AppendFormat appends e in the Extended Date/Time Format to dst and returns the extended buffer.
+func (e EDTF) AppendFormat(dst []byte) []byte {
+	switch e.Set {
+	case OneOf, AllOf:
+		open, closing := byte('['), byte(']')
+		if e.Set == AllOf {
+			open, closing = '{', '}'
+		}
+		dst = append(dst, open)
+		for i, m := range e.Members {
+			if i > 0 {
+				dst = append(dst, ',')
+			}
+			if !m.Interval {
+				dst = m.Start.AppendFormat(dst)
+				continue
+			}
+			// A range within a set is separated by `..`, and its open ends are empty.
+			if m.StartKind == EndDate {
+				dst = m.Start.AppendFormat(dst)
+			}
+			dst = append(dst, '.', '.')
+			if m.EndKind == EndDate {
+				dst = m.End.AppendFormat(dst)
+			}
+		}
+		return append(dst, closing)
+	}
+
+	if !e.Interval {
+		return e.Start.AppendFormat(dst)
+	}
+	dst = appendEDTFEnd(dst, e.StartKind, e.Start)
+	dst = append(dst, '/')
+	return appendEDTFEnd(dst, e.EndKind, e.End)
+}
+
+func appendEDTFEnd(dst []byte, kind IntervalEnd, d EDTFDate) []byte {
+	switch kind {
+	case EndOpen:
+		return append(dst, '.', '.')
+	case EndUnknown:
+		return dst
+	}
+	return d.AppendFormat(dst)
+}
+
+// MarshalText encodes e in the Extended Date/Time Format.
+func (e EDTF) MarshalText() ([]byte, error) {
+	return e.AppendFormat(nil), nil
+}
+
+// UnmarshalText decodes an Extended Date/Time Format expression.
+func (e *EDTF) UnmarshalText(b []byte) error {
+	var err error
+	*e, err = ParseEDTF(b)
+	return err
+}
+
+// MarshalJSON encodes e as a JSON string in the Extended Date/Time Format.
+func (e EDTF) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, 34), '"')
+	b = e.AppendFormat(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decodes a JSON string or null into an Extended Date/Time Format expression.
+func (e *EDTF) UnmarshalJSON(b []byte) error {
+	// Do not process null types
+	if null(b) {
+		return nil
+	}
+	b, err := unquote(b)
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalText(b)
+}
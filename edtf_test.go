@@ -0,0 +1,271 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEDTF(t *testing.T) {
+	var cases = []struct {
+		Using    string
+		Earliest time.Time
+		Latest   time.Time
+		Open     bool // one end of the interval is unbounded
+	}{
+		{Using: "1985-04-12", Earliest: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC), Latest: time.Date(1985, 4, 12, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1985-04", Earliest: time.Date(1985, 4, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1985, 4, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1985", Earliest: time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1985, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "0000", Earliest: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(0, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "-1985", Earliest: time.Date(-1985, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(-1985, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1985-04-12T23:20:30", Earliest: time.Date(1985, 4, 12, 23, 20, 30, 0, time.UTC), Latest: time.Date(1985, 4, 12, 23, 20, 30, 999999999, time.UTC)},
+		{Using: "1985-04-12T23:20:30-04:00", Earliest: time.Date(1985, 4, 13, 3, 20, 30, 0, time.UTC), Latest: time.Date(1985, 4, 13, 3, 20, 30, 999999999, time.UTC)},
+		{Using: "1984?", Earliest: time.Date(1984, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1984, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2004-06~", Earliest: time.Date(2004, 6, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 6, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2004-06-11%", Earliest: time.Date(2004, 6, 11, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 6, 11, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "201X", Earliest: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2019, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "20XX", Earliest: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2099, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2004-XX", Earliest: time.Date(2004, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1985-04-XX", Earliest: time.Date(1985, 4, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1985, 4, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1985-XX-XX", Earliest: time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1985, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "-1XXX", Earliest: time.Date(-1999, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(-1000, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "Y170000002", Earliest: time.Date(170000002, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(170000002, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "Y-170000002", Earliest: time.Date(-170000002, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(-170000002, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-21", Earliest: time.Date(2001, 3, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2001, 5, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-24", Earliest: time.Date(2001, 12, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2002, 2, 28, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1964/2008", Earliest: time.Date(1964, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2008, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2004-06/2006-08", Earliest: time.Date(2004, 6, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2006, 8, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1984?/2004~", Earliest: time.Date(1984, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1985-04-12/..", Earliest: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC), Open: true},
+		{Using: "../1985-04-12", Latest: time.Date(1985, 4, 12, 23, 59, 59, 999999999, time.UTC), Open: true},
+		{Using: "1985-04-12/", Earliest: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC), Open: true},
+		{Using: "/1985", Latest: time.Date(1985, 12, 31, 23, 59, 59, 999999999, time.UTC), Open: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			e, err := ParseEDTFString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := e.String(); s != c.Using {
+				t.Errorf("String = %q; want %q", s, c.Using)
+			}
+
+			earliest, ok := e.Earliest()
+			if ok == c.Earliest.IsZero() || (ok && !earliest.Equal(c.Earliest)) {
+				t.Errorf("Earliest = %s, %t; want %s", earliest, ok, c.Earliest)
+			}
+			latest, ok := e.Latest()
+			if ok == c.Latest.IsZero() || (ok && !latest.Equal(c.Latest)) {
+				t.Errorf("Latest = %s, %t; want %s", latest, ok, c.Latest)
+			}
+			if !c.Open && !e.Interval && !e.Start.Earliest().Equal(earliest) {
+				t.Errorf("Start.Earliest = %s; want %s", e.Start.Earliest(), earliest)
+			}
+
+			b, err := ParseEDTF([]byte(c.Using))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != e.String() {
+				t.Errorf("ParseEDTF = %s; want %s", b, e)
+			}
+		})
+	}
+}
+
+func TestParseEDTFComponents(t *testing.T) {
+	e, err := ParseEDTFString("1985-X4-1X~")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := EDTFDate{Year: 1985, Month: 4, Day: 10, Precision: PrecisionDay, Qualifier: Approximate, UnspecifiedMonth: 2, UnspecifiedDay: 1}
+	if e.Start != want {
+		t.Errorf("Start = %+v; want %+v", e.Start, want)
+	}
+	if e.Interval {
+		t.Error("expected a single date")
+	}
+}
+
+func TestParseEDTFLevel2(t *testing.T) {
+	var cases = []struct {
+		Using    string
+		Expect   string // the formatted expression, if different
+		Earliest time.Time
+		Latest   time.Time
+	}{
+		{Using: "2004-?06-11", Earliest: time.Date(2004, 6, 11, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 6, 11, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "?2004-06~-11", Expect: "%2004-~06-11", Earliest: time.Date(2004, 6, 11, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 6, 11, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2004?-06-11", Expect: "?2004-06-11", Earliest: time.Date(2004, 6, 11, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 6, 11, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2004-06-%11", Earliest: time.Date(2004, 6, 11, 0, 0, 0, 0, time.UTC), Latest: time.Date(2004, 6, 11, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "1950S2", Earliest: time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1999, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "Y171010000S3", Earliest: time.Date(171000000, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(171999999, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "Y-17E7", Earliest: time.Date(-170000000, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(-170000000, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-25", Earliest: time.Date(2001, 3, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2001, 5, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-29", Earliest: time.Date(2001, 9, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2001, 11, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-30", Earliest: time.Date(2001, 12, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2002, 2, 28, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-35", Earliest: time.Date(2001, 7, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2001, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-38", Earliest: time.Date(2001, 5, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2001, 8, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "2001-41", Earliest: time.Date(2001, 7, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(2001, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "[1667,1668,1670..1672]", Earliest: time.Date(1667, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1672, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "{1960,1961-12}", Earliest: time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), Latest: time.Date(1961, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "[..1760-12-03]", Latest: time.Date(1760, 12, 3, 23, 59, 59, 999999999, time.UTC)},
+		{Using: "[1760-01,1760-02,1760-12..]", Earliest: time.Date(1760, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			e, err := ParseEDTFString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expect := c.Expect
+			if expect == "" {
+				expect = c.Using
+			}
+			if s := e.String(); s != expect {
+				t.Errorf("String = %q; want %q", s, expect)
+			}
+
+			earliest, ok := e.Earliest()
+			if ok == c.Earliest.IsZero() || (ok && !earliest.Equal(c.Earliest)) {
+				t.Errorf("Earliest = %s, %t; want %s", earliest, ok, c.Earliest)
+			}
+			latest, ok := e.Latest()
+			if ok == c.Latest.IsZero() || (ok && !latest.Equal(c.Latest)) {
+				t.Errorf("Latest = %s, %t; want %s", latest, ok, c.Latest)
+			}
+		})
+	}
+}
+
+func TestEDTFEnumerate(t *testing.T) {
+	var cases = []struct {
+		Using  string
+		Expect []string
+		Err    error
+	}{
+		{Using: "1985-04-12", Expect: []string{"1985-04-12"}},
+		{Using: "[1667,1668,1670..1672]", Expect: []string{"1667", "1668", "1670", "1671", "1672"}},
+		{Using: "{1960,1961-12}", Expect: []string{"1960", "1961-12"}},
+		{Using: "2004-11/2005-02", Expect: []string{"2004-11", "2004-12", "2005-01", "2005-02"}},
+		{Using: "2004-02-27/2004-03", Expect: []string{"2004-02-27", "2004-02-28", "2004-02-29", "2004-03-01", "2004-03-02"}},
+		{Using: "1985/..", Err: ErrNotEnumerable},
+		{Using: "[1760-12..]", Err: ErrNotEnumerable},
+		{Using: "201X/2020", Err: ErrNotEnumerable},
+		{Using: "2001-21/2001-24", Err: ErrNotEnumerable},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			e, err := ParseEDTFString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			err = e.Enumerate(func(d EDTFDate) bool {
+				got = append(got, d.String())
+				// Stop early so that the last case does not enumerate every day of March.
+				return len(got) < 5
+			})
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+			if c.Err != nil {
+				return
+			}
+			if len(got) != len(c.Expect) {
+				t.Fatalf("Enumerate = %v; want %v", got, c.Expect)
+			}
+			for i := range got {
+				if got[i] != c.Expect[i] {
+					t.Errorf("Enumerate = %v; want %v", got, c.Expect)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestParseEDTFErrors(t *testing.T) {
+	var cases = []struct {
+		Using string
+		Err   error
+	}{
+		{Using: "", Err: ErrUnexpectedEnd},
+		{Using: "198", Err: ErrUnexpectedEnd},
+		{Using: "1985-13", Err: &RangeError{Element: "month", Given: 13}},
+		{Using: "1985-42", Err: &RangeError{Element: "month", Given: 42}},
+		{Using: "1985-2X", Err: &RangeError{Element: "month", Given: 20}},
+		{Using: "1985-02-29", Err: &RangeError{Element: "day", Given: 29}},
+		{Using: "1985-02-3X", Err: &RangeError{Element: "day", Given: 30}},
+		{Using: "XXXX-02-30", Err: &RangeError{Element: "day", Given: 30, Max: 29}},
+		{Using: "1985-02-30", Err: &RangeError{Element: "day", Given: 30, Max: 28}},
+		{Using: "XXXX-04-31", Err: &RangeError{Element: "day", Given: 31, Max: 30}},
+		{Using: "XXX1-02-29", Err: &RangeError{Element: "day", Given: 29, Max: 28}},
+		{Using: "1985-X2-32", Err: &RangeError{Element: "day", Given: 32, Max: 31}},
+		{Using: "2001-21-01", Err: UnexpectedCharacterError{Character: '-'}},
+		{Using: "1985-04-12x", Err: UnexpectedCharacterError{Character: 'x'}},
+		{Using: "1985?~", Err: UnexpectedCharacterError{Character: '?'}},
+		{Using: "1985-04-12T23:20:30?", Err: UnexpectedCharacterError{Character: 'T'}},
+		{Using: "1985-04-12T23:20", Err: ErrUnsupportedPrecision},
+		{Using: "11985-04-12T23:20:30+01:00", Err: UnexpectedCharacterError{Character: '5'}},
+		{Using: "-11985-04-12T23:20:30Z", Err: UnexpectedCharacterError{Character: '5'}},
+		{Using: "Y2020", Err: &RangeError{Element: "year", Given: 2020}},
+		{Using: "Y", Err: ErrUnexpectedEnd},
+		{Using: "2008/1964", Err: ErrIntervalOrder},
+		{Using: "../..", Err: UnexpectedCharacterError{Character: '/'}},
+		{Using: "/", Err: UnexpectedCharacterError{Character: '/'}},
+		{Using: "1964/2008/2010", Err: UnexpectedCharacterError{Character: '/'}},
+		{Using: "1950S5", Err: &RangeError{Element: "significant digits", Given: 5}},
+		{Using: "1950S0", Err: &RangeError{Element: "significant digits", Given: 0}},
+		{Using: "1950S2-01", Err: UnexpectedCharacterError{Character: '-'}},
+		{Using: "Y17E", Err: ErrUnexpectedEnd},
+		{Using: "[1667,1668", Err: ErrUnexpectedEnd},
+		{Using: "[1667,..1668]", Err: UnexpectedCharacterError{Character: '.'}},
+		{Using: "[1668..1667]", Err: ErrIntervalOrder},
+		{Using: "[..]", Err: UnexpectedCharacterError{Character: '.'}},
+		{Using: "{1667,}", Err: ErrUnexpectedEnd},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			_, err := ParseEDTFString(c.Using)
+			if want, ok := c.Err.(*RangeError); ok {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || rangeErr.Element != want.Element || rangeErr.Given != want.Given {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				if want.Max != 0 && rangeErr.Max != want.Max {
+					t.Errorf("Max = %d; want %d", rangeErr.Max, want.Max)
+				}
+				return
+			}
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+		})
+	}
+}
+
+func TestEDTFJSON(t *testing.T) {
+	var v struct {
+		Date EDTF
+	}
+	if err := json.Unmarshal([]byte(`{"Date": "1984?/2004-06~"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Date":"1984?/2004-06~"}` {
+		t.Errorf("json.Marshal = %s", s)
+	}
+	if err := json.Unmarshal([]byte(`{"Date": 1984}`), &v); !errors.Is(err, ErrNotString) {
+		t.Errorf("expected to return error %v, got %v", ErrNotString, err)
+	}
+}
@@ -0,0 +1,199 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"time"
+)
+
+var (
+	_ json.Marshaler   = EpochTime{}
+	_ json.Unmarshaler = &EpochTime{}
+	_ json.Marshaler   = UnixTime{}
+	_ json.Unmarshaler = &UnixTime{}
+	_ json.Marshaler   = UnixMilliTime{}
+	_ json.Unmarshaler = &UnixMilliTime{}
+	_ json.Marshaler   = UnixMicroTime{}
+	_ json.Unmarshaler = &UnixMicroTime{}
+)
+
+// epochUnit is the unit of a Unix epoch number.
+type epochUnit uint8
+
+const (
+	// epochAuto detects the unit from the magnitude of the number.
+	epochAuto epochUnit = iota
+	epochSeconds
+	epochMilliseconds
+	epochMicroseconds
+)
+
+// Magnitudes from which an epoch number is taken to be in milliseconds or microseconds by epochAuto.
+// As seconds or milliseconds respectively, both are beyond the year 5000.
+const (
+	epochMillisecondsFrom = 1e11
+	epochMicrosecondsFrom = 1e14
+)
+
+// maxEpochDigits is the number of digits in the integer part of an epoch number that fit in an int64.
+const maxEpochDigits = 18
+
+// EpochTime is a helper object for decoding a JSON string containing an ISO8601 time,
+// or a JSON number of seconds, milliseconds or microseconds since the Unix epoch.
+// The unit of a number is detected from its magnitude: numbers from 1e11 are taken to be in milliseconds,
+// and numbers from 1e14 in microseconds, so that each unit covers the years up to at least 5000.
+// A number may have a fraction, but not an exponent.
+// It is always encoded as a JSON string in the same format as time.RFC3339Nano.
+type EpochTime struct {
+	time.Time
+}
+
+// MarshalJSON encodes the time as a JSON string in the same format as time.RFC3339Nano.
+func (t EpochTime) MarshalJSON() ([]byte, error) {
+	return marshalEpochJSON(t.Time), nil
+}
+
+// UnmarshalJSON decodes a JSON string containing an ISO8601 time, a JSON number of seconds, milliseconds
+// or microseconds since the Unix epoch, or null.
+func (t *EpochTime) UnmarshalJSON(b []byte) error {
+	return unmarshalEpochJSON(&t.Time, b, epochAuto)
+}
+
+// UnixTime is an EpochTime that decodes JSON numbers as seconds since the Unix epoch.
+type UnixTime struct {
+	time.Time
+}
+
+// MarshalJSON encodes the time as a JSON string in the same format as time.RFC3339Nano.
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	return marshalEpochJSON(t.Time), nil
+}
+
+// UnmarshalJSON decodes a JSON string containing an ISO8601 time, a JSON number of seconds since the Unix epoch, or null.
+func (t *UnixTime) UnmarshalJSON(b []byte) error {
+	return unmarshalEpochJSON(&t.Time, b, epochSeconds)
+}
+
+// UnixMilliTime is an EpochTime that decodes JSON numbers as milliseconds since the Unix epoch.
+type UnixMilliTime struct {
+	time.Time
+}
+
+// MarshalJSON encodes the time as a JSON string in the same format as time.RFC3339Nano.
+func (t UnixMilliTime) MarshalJSON() ([]byte, error) {
+	return marshalEpochJSON(t.Time), nil
+}
+
+// UnmarshalJSON decodes a JSON string containing an ISO8601 time, a JSON number of milliseconds since the Unix epoch, or null.
+func (t *UnixMilliTime) UnmarshalJSON(b []byte) error {
+	return unmarshalEpochJSON(&t.Time, b, epochMilliseconds)
+}
+
+// UnixMicroTime is an EpochTime that decodes JSON numbers as microseconds since the Unix epoch.
+type UnixMicroTime struct {
+	time.Time
+}
+
+// MarshalJSON encodes the time as a JSON string in the same format as time.RFC3339Nano.
+func (t UnixMicroTime) MarshalJSON() ([]byte, error) {
+	return marshalEpochJSON(t.Time), nil
+}
+
+// UnmarshalJSON decodes a JSON string containing an ISO8601 time, a JSON number of microseconds since the Unix epoch, or null.
+func (t *UnixMicroTime) UnmarshalJSON(b []byte) error {
+	return unmarshalEpochJSON(&t.Time, b, epochMicroseconds)
+}
+
+func marshalEpochJSON(t time.Time) []byte {
+	b := append(make([]byte, 0, 37), '"')
+	b = AppendFormat(b, t, FormatOptions{})
+	return append(b, '"')
+}
+
+func unmarshalEpochJSON(t *time.Time, b []byte, unit epochUnit) error {
+	// Do not process null types
+	if null(b) {
+		return nil
+	}
+	b = trimJSONSpace(b)
+	if len(b) > 0 && (b[0] == '-' || isDigit(b[0])) {
+		var err error
+		*t, err = parseEpoch(b, unit)
+		return err
+	}
+	b, err := unquote(b)
+	if err != nil {
+		return err
+	}
+	*t, err = Parse(b)
+	return err
+}
+
+// parseEpoch parses a JSON number of seconds, milliseconds or microseconds since the Unix epoch as a time in UTC.
+// A fraction is accepted to nanosecond precision, and any further digits are truncated.
+func parseEpoch(b []byte, unit epochUnit) (time.Time, error) {
+	var i int
+	neg := b[0] == '-'
+	if neg {
+		i++
+	}
+
+	var v int64
+	start := i
+	for ; i < len(b) && isDigit(b[i]); i++ {
+		if i-start == maxEpochDigits {
+			return time.Time{}, ErrEpochOverflow
+		}
+		v = v*10 + int64(b[i]-'0')
+	}
+	if i == start {
+		return time.Time{}, unexpected(b, i)
+	}
+
+	// The fraction is kept to nine digits, which is enough for nanoseconds of a second.
+	var fraction, digits int64
+	if i < len(b) && b[i] == '.' {
+		i++
+		start = i
+		for ; i < len(b) && isDigit(b[i]); i++ {
+			if digits < 9 {
+				fraction = fraction*10 + int64(b[i]-'0')
+				digits++
+			}
+		}
+		if i == start {
+			return time.Time{}, unexpected(b, i)
+		}
+	}
+	if i < len(b) {
+		return time.Time{}, newUnexpectedCharacterError(b[i])
+	}
+	for ; digits < 9; digits++ {
+		fraction *= 10
+	}
+
+	if unit == epochAuto {
+		switch {
+		case v >= epochMicrosecondsFrom:
+			unit = epochMicroseconds
+		case v >= epochMillisecondsFrom:
+			unit = epochMilliseconds
+		default:
+			unit = epochSeconds
+		}
+	}
+	perSecond := int64(1)
+	switch unit {
+	case epochMilliseconds:
+		perSecond = 1e3
+	case epochMicroseconds:
+		perSecond = 1e6
+	}
+
+	// The nanoseconds of the fraction are those of a unit, so are divided by the units in a second.
+	sec := v / perSecond
+	nsec := v%perSecond*(1e9/perSecond) + fraction/perSecond
+	if neg {
+		sec, nsec = -sec, -nsec
+	}
+	return time.Unix(sec, nsec).UTC(), nil
+}
@@ -0,0 +1,100 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEpochTime_UnmarshalJSON(t *testing.T) {
+	var cases = []struct {
+		Using  string
+		Expect time.Time
+	}{
+		{Using: `1600000000`, Expect: time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)},
+		{Using: `1600000000.25`, Expect: time.Date(2020, 9, 13, 12, 26, 40, 250000000, time.UTC)},
+		{Using: `1600000000250`, Expect: time.Date(2020, 9, 13, 12, 26, 40, 250000000, time.UTC)},
+		{Using: `1600000000250001`, Expect: time.Date(2020, 9, 13, 12, 26, 40, 250001000, time.UTC)},
+		{Using: `1600000000250.5`, Expect: time.Date(2020, 9, 13, 12, 26, 40, 250500000, time.UTC)},
+		{Using: `-86400`, Expect: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{Using: `-0.5`, Expect: time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)},
+		{Using: `0`, Expect: time.Unix(0, 0).UTC()},
+		{Using: ` 1600000000 `, Expect: time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)},
+		{Using: `"2020-09-13T13:26:40+01:00"`, Expect: time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			var e EpochTime
+			if err := e.UnmarshalJSON([]byte(c.Using)); err != nil {
+				t.Fatal(err)
+			}
+			if !e.Equal(c.Expect) {
+				t.Errorf("UnmarshalJSON = %s; want %s", e.Time, c.Expect)
+			}
+		})
+	}
+}
+
+func TestUnixTime_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		S  UnixTime
+		Ms UnixMilliTime
+		Us UnixMicroTime
+	}
+	if err := json.Unmarshal([]byte(`{"S": 1000, "Ms": 1000, "Us": 1000.5}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(1000, 0); !v.S.Equal(want) {
+		t.Errorf("S = %s; want %s", v.S.Time, want)
+	}
+	if want := time.Unix(1, 0); !v.Ms.Equal(want) {
+		t.Errorf("Ms = %s; want %s", v.Ms.Time, want)
+	}
+	if want := time.Unix(0, 1000500); !v.Us.Equal(want) {
+		t.Errorf("Us = %s; want %s", v.Us.Time, want)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"S":"1970-01-01T00:16:40Z","Ms":"1970-01-01T00:00:01Z","Us":"1970-01-01T00:00:00.0010005Z"}` {
+		t.Errorf("json.Marshal = %s", s)
+	}
+}
+
+func TestEpochTime_UnmarshalJSONErrors(t *testing.T) {
+	var cases = []struct {
+		Using string
+		Err   error
+	}{
+		{Using: `1.6e9`, Err: UnexpectedCharacterError{Character: 'e'}},
+		{Using: `1.`, Err: ErrUnexpectedEnd},
+		{Using: `-`, Err: ErrUnexpectedEnd},
+		{Using: `-x`, Err: UnexpectedCharacterError{Character: 'x'}},
+		{Using: `1234567890123456789`, Err: ErrEpochOverflow},
+		{Using: `true`, Err: ErrNotString},
+		{Using: `"soon"`, Err: UnexpectedCharacterError{Character: 's'}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			var e EpochTime
+			if err := e.UnmarshalJSON([]byte(c.Using)); !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+		})
+	}
+}
+
+func TestEpochTime_Null(t *testing.T) {
+	e := EpochTime{Time: time.Unix(1, 0)}
+	if err := json.Unmarshal([]byte(`null`), &e); err != nil {
+		t.Fatal(err)
+	}
+	if !e.Equal(time.Unix(1, 0)) {
+		t.Errorf("UnmarshalJSON(null) = %s; want the time to be unchanged", e.Time)
+	}
+}
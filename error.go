@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	// ErrZoneCharacters indicates an incorrect amount of characters was passed to ParseISOZone.
-	ErrZoneCharacters = errors.New("iso8601: Expected 1 or between 3 and 6 characters for zone information")
+	// ErrZoneCharacters indicates an incorrect amount of characters was passed to ParseISOZone,
+	// such as an offset that ends within a component (`+01:0`).
+	ErrZoneCharacters = errors.New("iso8601: Incomplete or too long zone information")
 
 	// ErrInvalidZone indicates an invalid timezone per the standard that doesn't violate any specific
 	// character parsing rules.
@@ -17,8 +18,63 @@ var (
 	ErrRemainingData = errors.New("iso8601: Unexpected remaining data after `Z`")
 
 	// ErrNotString indicates that a non string type was passed to the UnmarshalJSON method of `Time`.
+	// It is matched by the *JSONTypeError returned by UnmarshalJSON methods.
 	ErrNotString = errors.New("iso8601: Invalid json type (expected string)")
 
+	// ErrUnexpectedEnd indicates that the input ended before all of its required components were given.
+	ErrUnexpectedEnd = errors.New("iso8601: Unexpected end of input")
+
+	// ErrDurationOverflow indicates that a component of a duration has too many digits to be represented,
+	// or is too large to be added to a time.
+	ErrDurationOverflow = errors.New("iso8601: Duration component is too large")
+
+	// ErrDurationWeeks indicates that a duration combines weeks with other components.
+	ErrDurationWeeks = errors.New("iso8601: Duration weeks cannot be combined with other components")
+
+	// ErrCalendarDuration indicates that a duration with years or months, which have no fixed length,
+	// was converted to or compared as an exact amount of time.
+	ErrCalendarDuration = errors.New("iso8601: Duration has years or months which have no fixed length")
+
+	// ErrNominalDuration indicates that a duration with days or weeks, which are nominal units
+	// that are not always 24 hours long, was decoded into a StdDuration.
+	ErrNominalDuration = errors.New("iso8601: Duration has days or weeks which have no fixed length")
+
+	// ErrMonthEnd indicates that adding a duration to a time resulted in a day that does not exist in the resulting month
+	// when using the MonthEndReject policy.
+	ErrMonthEnd = errors.New("iso8601: Day does not exist at the end of the resulting month")
+
+	// ErrUnsupportedPrecision indicates that the precision of the input, such as a date without a time,
+	// is not accepted by ParseOptions.Precisions.
+	ErrUnsupportedPrecision = errors.New("iso8601: Precision of input is not accepted")
+
+	// ErrMissingZone indicates that an input with a time of day has no zone information
+	// when it is required by ParseOptions.RequireZone, or by ASN1Mode.ParseGeneralizedTime for a BER local time.
+	ErrMissingZone = errors.New("iso8601: Expected zone information")
+
+	// ErrNegativeDuration indicates that a negative duration cannot be formatted by a DurationProfile that does not accept one.
+	ErrNegativeDuration = errors.New("iso8601: Negative duration is not accepted")
+
+	// ErrIntervalOrder indicates that an interval ends before it starts.
+	ErrIntervalOrder = errors.New("iso8601: Interval ends before it starts")
+
+	// ErrNotEnumerable indicates that the dates denoted by an EDTF expression cannot be enumerated.
+	ErrNotEnumerable = errors.New("iso8601: EDTF expression cannot be enumerated")
+
+	// ErrNonexistentTime indicates that a wall clock time is skipped by a daylight saving time transition in the location
+	// when using the DSTReject policy.
+	ErrNonexistentTime = errors.New("iso8601: Time does not exist in the location")
+
+	// ErrAmbiguousTime indicates that a wall clock time is repeated by a daylight saving time transition in the location
+	// when using the DSTReject policy.
+	ErrAmbiguousTime = errors.New("iso8601: Time is ambiguous in the location")
+
+	// ErrOffsetMismatch indicates that the offset of an input differs from that of the location it is parsed in
+	// when using ParseOptions.MatchLocationOffset.
+	ErrOffsetMismatch = errors.New("iso8601: Offset does not match the location")
+
+	// ErrEpochOverflow indicates that a Unix epoch number has too many digits to be represented as a time.
+	ErrEpochOverflow = errors.New("iso8601: Epoch number is too large")
+
 	// ErrPrecision indicates that there was too much precision (characters) given to parse
 	// for the fraction of a second of the input time.
 	ErrPrecision = errors.New("iso8601: Too many characters in fraction of second precision")
@@ -28,6 +84,15 @@ func newUnexpectedCharacterError(c byte) error {
 	return UnexpectedCharacterError{Character: c}
 }
 
+// unexpected returns the error for the character at position i of inp,
+// or ErrUnexpectedEnd if i is past the end of the input.
+func unexpected[T input](inp T, i int) error {
+	if i >= len(inp) {
+		return ErrUnexpectedEnd
+	}
+	return newUnexpectedCharacterError(inp[i])
+}
+
 // UnexpectedCharacterError indicates the parser scanned a character that was not expected at that time.
 type UnexpectedCharacterError struct {
 	Character byte
@@ -37,6 +102,22 @@ func (e UnexpectedCharacterError) Error() string {
 	return fmt.Sprintf("iso8601: Unexpected character `%c`", e.Character)
 }
 
+// JSONTypeError indicates that a JSON value other than a string, such as a number, was decoded into a type
+// that is encoded as a JSON string. It matches ErrNotString with errors.Is.
+type JSONTypeError struct {
+	// Type is the type of the JSON value, such as "number", "boolean", "object", "array" or "invalid string".
+	Type string
+}
+
+func (e *JSONTypeError) Error() string {
+	return fmt.Sprintf("iso8601: Invalid json type %s (expected string)", e.Type)
+}
+
+// Is reports whether target is ErrNotString.
+func (e *JSONTypeError) Is(target error) bool {
+	return target == ErrNotString
+}
+
 // RangeError indicates that a value is not in an expected range.
 type RangeError struct {
 	Value   string
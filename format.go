@@ -0,0 +1,293 @@
+package iso8601
+
+import (
+	"strconv"
+	"time"
+)
+
+// DateForm selects how the date of a time is represented when formatting,
+// or the representation of the date that is accepted when parsing with ParseOptions.
+type DateForm uint8
+
+const (
+	// DateCalendar formats the date as a year, month and day of the month (`2006-01-02`).
+	DateCalendar DateForm = iota
+	// DateOrdinal formats the date as a year and day of the year (`2006-002`).
+	DateOrdinal
+	// DateWeek formats the date as an ISO week-numbering year, week and day of the week (`2006-W01-1`).
+	DateWeek
+	// DateMonthDay formats the month and day of the month without a year (`--01-02`).
+	DateMonthDay
+	// DateOmit formats the time of day only, without a date or the `T` designator (`15:04:05Z`).
+	DateOmit
+)
+
+// Precision is the smallest component of a time written when formatting.
+// The zero value writes all components down to the nanosecond.
+type Precision uint8
+
+const (
+	PrecisionNanosecond Precision = iota
+	PrecisionMicrosecond
+	PrecisionMillisecond
+	PrecisionSecond
+	PrecisionMinute
+	PrecisionHour
+	PrecisionDay
+	// PrecisionMonth writes the week of a DateWeek representation.
+	// A DateOrdinal representation has no equivalent and writes the year only.
+	PrecisionMonth
+	PrecisionYear
+)
+
+// PrecisionSet is a set of precisions, used to restrict the precision of input accepted by ParseOptions.
+type PrecisionSet uint16
+
+// Precisions returns the set of the given precisions.
+func Precisions(ps ...Precision) PrecisionSet {
+	var s PrecisionSet
+	for _, p := range ps {
+		s |= 1 << p
+	}
+	return s
+}
+
+// Contains reports whether p is in s.
+func (s PrecisionSet) Contains(p Precision) bool {
+	return s&(1<<p) != 0
+}
+
+// ZoneFormat selects how the zone of a time is represented when formatting.
+// The zone is only written if the formatted precision includes the time of day, or with FormatOptions.DateZone.
+// It is never written for a time in the NoZone location.
+type ZoneFormat uint8
+
+const (
+	// ZoneFormatZ writes `Z` for UTC, and otherwise an offset in the same basic or extended format as the rest of the time.
+	ZoneFormatZ ZoneFormat = iota
+	// ZoneFormatExtended always writes an offset with a colon, such as `+00:00`.
+	ZoneFormatExtended
+	// ZoneFormatBasic always writes an offset without a colon, such as `+0000`.
+	ZoneFormatBasic
+	// ZoneFormatOmit does not write the zone.
+	ZoneFormatOmit
+)
+
+// FormatOptions configures how a time is formatted.
+// The zero value formats a time in the same way as the time.RFC3339Nano layout.
+type FormatOptions struct {
+	// Date selects the calendar, ordinal, week or month and day representation of the date, or omits the date.
+	Date DateForm
+
+	// Basic writes the basic format without separators (`20060102T150405Z`)
+	// instead of the extended format (`2006-01-02T15:04:05Z`).
+	Basic bool
+
+	// Precision is the smallest component written.
+	Precision Precision
+
+	// FixedFraction always writes all digits of the fraction of a second given by Precision.
+	// Otherwise trailing zeros are removed, along with the decimal sign if the fraction is zero.
+	FixedFraction bool
+
+	// DecimalComma uses `,` instead of `.` as the decimal sign of the fraction of a second.
+	DecimalComma bool
+
+	// Zone selects how the zone is written.
+	Zone ZoneFormat
+
+	// DateZone writes the zone after a date without a time of day, as used by XML Schema (`2006-01-02-05:00`).
+	DateZone bool
+}
+
+// Format returns an ISO8601 representation of t.
+func Format(t time.Time, opts FormatOptions) string {
+	return string(AppendFormat(make([]byte, 0, 64), t, opts))
+}
+
+// AppendFormat is like Format but appends the representation of t to dst and returns the extended buffer.
+func AppendFormat(dst []byte, t time.Time, opts FormatOptions) []byte {
+	p := opts.Precision
+
+	switch opts.Date {
+	case DateOrdinal:
+		dst = appendYear(dst, t.Year())
+		if p <= PrecisionDay {
+			if !opts.Basic {
+				dst = append(dst, '-')
+			}
+			dst = appendInt(dst, t.YearDay(), 3)
+		}
+	case DateOmit:
+		if p > PrecisionHour {
+			return dst
+		}
+	case DateMonthDay:
+		_, month, day := t.Date()
+		dst = append(dst, '-', '-')
+		dst = appendInt(dst, int(month), 2)
+		if p <= PrecisionDay {
+			if !opts.Basic {
+				dst = append(dst, '-')
+			}
+			dst = appendInt(dst, day, 2)
+		}
+	case DateWeek:
+		year, week := t.ISOWeek()
+		dst = appendYear(dst, year)
+		if p <= PrecisionMonth {
+			if !opts.Basic {
+				dst = append(dst, '-')
+			}
+			dst = append(dst, 'W')
+			dst = appendInt(dst, week, 2)
+		}
+		if p <= PrecisionDay {
+			if !opts.Basic {
+				dst = append(dst, '-')
+			}
+			dst = appendInt(dst, isoWeekday(t.Weekday()), 1)
+		}
+	default:
+		year, month, day := t.Date()
+		dst = appendYear(dst, year)
+		if p <= PrecisionMonth {
+			// The basic format has no year and month representation without a day (YYYYMM),
+			// as it would be ambiguous with a two digit year (YYMMDD).
+			if !opts.Basic || p == PrecisionMonth {
+				dst = append(dst, '-')
+			}
+			dst = appendInt(dst, int(month), 2)
+		}
+		if p <= PrecisionDay {
+			if !opts.Basic {
+				dst = append(dst, '-')
+			}
+			dst = appendInt(dst, day, 2)
+		}
+	}
+
+	if p > PrecisionHour {
+		if opts.DateZone {
+			return appendZone(dst, t, opts)
+		}
+		return dst
+	}
+
+	hour, min, sec := t.Clock()
+	if opts.Date != DateOmit {
+		dst = append(dst, 'T')
+	}
+	dst = appendInt(dst, hour, 2)
+	if p <= PrecisionMinute {
+		if !opts.Basic {
+			dst = append(dst, ':')
+		}
+		dst = appendInt(dst, min, 2)
+	}
+	if p <= PrecisionSecond {
+		if !opts.Basic {
+			dst = append(dst, ':')
+		}
+		dst = appendInt(dst, sec, 2)
+	}
+	if p < PrecisionSecond {
+		dst = appendFraction(dst, t.Nanosecond(), p, opts)
+	}
+
+	return appendZone(dst, t, opts)
+}
+
+// appendFraction appends the fraction of a second in nsec to the number of digits given by the precision p.
+func appendFraction(dst []byte, nsec int, p Precision, opts FormatOptions) []byte {
+	digits := 9
+	switch p {
+	case PrecisionMillisecond:
+		digits = 3
+	case PrecisionMicrosecond:
+		digits = 6
+	}
+	for i := digits; i < 9; i++ {
+		nsec /= 10
+	}
+	if !opts.FixedFraction {
+		if nsec == 0 {
+			return dst
+		}
+		for nsec%10 == 0 {
+			nsec /= 10
+			digits--
+		}
+	}
+
+	if opts.DecimalComma {
+		dst = append(dst, ',')
+	} else {
+		dst = append(dst, '.')
+	}
+	return appendInt(dst, nsec, digits)
+}
+
+// appendZone appends the zone of t as selected by opts.
+func appendZone(dst []byte, t time.Time, opts FormatOptions) []byte {
+	if opts.Zone == ZoneFormatOmit || t.Location() == NoZone {
+		return dst
+	}
+
+	_, offset := t.Zone()
+	unknown := t.Location() == UnknownOffset
+	if offset == 0 && !unknown && opts.Zone == ZoneFormatZ {
+		return append(dst, 'Z')
+	}
+
+	extended := opts.Zone == ZoneFormatExtended || (opts.Zone == ZoneFormatZ && !opts.Basic)
+	if offset < 0 || unknown {
+		dst = append(dst, '-')
+		offset = -offset
+	} else {
+		dst = append(dst, '+')
+	}
+	dst = appendInt(dst, offset/3600, 2)
+	if extended {
+		dst = append(dst, ':')
+	}
+	dst = appendInt(dst, offset%3600/60, 2)
+	if offset%60 != 0 {
+		if extended {
+			dst = append(dst, ':')
+		}
+		dst = appendInt(dst, offset%60, 2)
+	}
+	return dst
+}
+
+// appendYear appends a year with at least four digits.
+// Years outside of 0000-9999 are written in the expanded representation with a leading sign.
+func appendYear(dst []byte, year int) []byte {
+	switch {
+	case year < 0:
+		dst = append(dst, '-')
+		year = -year
+	case year > 9999:
+		dst = append(dst, '+')
+	}
+	return appendInt(dst, year, 4)
+}
+
+// appendInt appends the non-negative integer v padded with leading zeros to at least width digits.
+func appendInt(dst []byte, v int, width int) []byte {
+	for w := 1; w < width; w++ {
+		if v < pow10(w) {
+			dst = append(dst, '0')
+		}
+	}
+	return strconv.AppendInt(dst, int64(v), 10)
+}
+
+func pow10(n int) int {
+	p := 1
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
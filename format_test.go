@@ -0,0 +1,159 @@
+package iso8601
+
+import (
+	"testing"
+	"time"
+)
+
+var formatTime = time.Date(2017, 4, 24, 9, 41, 34, 502000000, time.FixedZone("", 5*3600+45*60))
+
+var formatCases = []struct {
+	Options FormatOptions
+	Expect  string
+}{
+	{Expect: "2017-04-24T09:41:34.502+05:45"},
+	{Options: FormatOptions{Basic: true}, Expect: "20170424T094134.502+0545"},
+	{Options: FormatOptions{Precision: PrecisionYear}, Expect: "2017"},
+	{Options: FormatOptions{Precision: PrecisionMonth}, Expect: "2017-04"},
+	{Options: FormatOptions{Precision: PrecisionMonth, Basic: true}, Expect: "2017-04"},
+	{Options: FormatOptions{Precision: PrecisionDay}, Expect: "2017-04-24"},
+	{Options: FormatOptions{Precision: PrecisionDay, Basic: true}, Expect: "20170424"},
+	{Options: FormatOptions{Precision: PrecisionHour}, Expect: "2017-04-24T09+05:45"},
+	{Options: FormatOptions{Precision: PrecisionMinute}, Expect: "2017-04-24T09:41+05:45"},
+	{Options: FormatOptions{Precision: PrecisionSecond}, Expect: "2017-04-24T09:41:34+05:45"},
+	{Options: FormatOptions{Precision: PrecisionMillisecond}, Expect: "2017-04-24T09:41:34.502+05:45"},
+	{Options: FormatOptions{Precision: PrecisionMicrosecond, FixedFraction: true}, Expect: "2017-04-24T09:41:34.502000+05:45"},
+	{Options: FormatOptions{FixedFraction: true}, Expect: "2017-04-24T09:41:34.502000000+05:45"},
+	{Options: FormatOptions{DecimalComma: true}, Expect: "2017-04-24T09:41:34,502+05:45"},
+	{Options: FormatOptions{Zone: ZoneFormatBasic}, Expect: "2017-04-24T09:41:34.502+0545"},
+	{Options: FormatOptions{Zone: ZoneFormatExtended, Basic: true}, Expect: "20170424T094134.502+05:45"},
+	{Options: FormatOptions{Zone: ZoneFormatOmit}, Expect: "2017-04-24T09:41:34.502"},
+	{Options: FormatOptions{Date: DateOrdinal}, Expect: "2017-114T09:41:34.502+05:45"},
+	{Options: FormatOptions{Date: DateOrdinal, Basic: true, Precision: PrecisionDay}, Expect: "2017114"},
+	{Options: FormatOptions{Date: DateOrdinal, Precision: PrecisionMonth}, Expect: "2017"},
+	{Options: FormatOptions{Date: DateWeek}, Expect: "2017-W17-1T09:41:34.502+05:45"},
+	{Options: FormatOptions{Date: DateWeek, Basic: true, Precision: PrecisionDay}, Expect: "2017W171"},
+	{Options: FormatOptions{Date: DateWeek, Precision: PrecisionMonth}, Expect: "2017-W17"},
+	{Options: FormatOptions{Date: DateMonthDay, Precision: PrecisionDay}, Expect: "--04-24"},
+	{Options: FormatOptions{Date: DateMonthDay, Basic: true, Precision: PrecisionDay}, Expect: "--0424"},
+	{Options: FormatOptions{Date: DateMonthDay, Precision: PrecisionMinute}, Expect: "--04-24T09:41+05:45"},
+	{Options: FormatOptions{Date: DateOmit}, Expect: "09:41:34.502+05:45"},
+	{Options: FormatOptions{Date: DateOmit, Basic: true, Precision: PrecisionSecond}, Expect: "094134+0545"},
+	{Options: FormatOptions{Date: DateOmit, Precision: PrecisionDay}, Expect: ""},
+}
+
+func TestFormat(t *testing.T) {
+	for _, tc := range formatCases {
+		t.Run(tc.Expect, func(t *testing.T) {
+			if s := Format(formatTime, tc.Options); s != tc.Expect {
+				t.Errorf("Format = %q; want %q", s, tc.Expect)
+			}
+		})
+	}
+}
+
+func TestFormatZone(t *testing.T) {
+	var zoneCases = []struct {
+		Time    time.Time
+		Options FormatOptions
+		Expect  string
+	}{
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, time.UTC), Expect: "2017-04-24T09:41:34Z"},
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, time.UTC), Options: FormatOptions{Zone: ZoneFormatExtended}, Expect: "2017-04-24T09:41:34+00:00"},
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, time.UTC), Options: FormatOptions{Zone: ZoneFormatBasic}, Expect: "2017-04-24T09:41:34+0000"},
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, UnknownOffset), Expect: "2017-04-24T09:41:34-00:00"},
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, UnknownOffset), Options: FormatOptions{Basic: true}, Expect: "20170424T094134-0000"},
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, time.FixedZone("", -5*3600)), Expect: "2017-04-24T09:41:34-05:00"},
+		{Time: time.Date(1890, 1, 1, 0, 0, 0, 0, time.FixedZone("", 9*60+21)), Expect: "1890-01-01T00:00:00+00:09:21"},
+		{Time: time.Date(1890, 1, 1, 0, 0, 0, 0, time.FixedZone("", 9*60+21)), Options: FormatOptions{Basic: true}, Expect: "18900101T000000+000921"},
+		{Time: time.Date(2017, 4, 24, 0, 0, 0, 0, time.FixedZone("", -5*3600)), Options: FormatOptions{Precision: PrecisionDay}, Expect: "2017-04-24"},
+		{Time: time.Date(2017, 4, 24, 0, 0, 0, 0, time.FixedZone("", -5*3600)), Options: FormatOptions{Precision: PrecisionDay, DateZone: true}, Expect: "2017-04-24-05:00"},
+		{Time: time.Date(2017, 4, 24, 0, 0, 0, 0, time.FixedZone("", 0)), Options: FormatOptions{Precision: PrecisionDay, DateZone: true}, Expect: "2017-04-24Z"},
+		{Time: time.Date(2017, 4, 24, 0, 0, 0, 0, time.UTC), Options: FormatOptions{Precision: PrecisionMonth, DateZone: true}, Expect: "2017-04Z"},
+		{Time: time.Date(2017, 4, 24, 0, 0, 0, 0, NoZone), Options: FormatOptions{Precision: PrecisionMonth, DateZone: true}, Expect: "2017-04"},
+		{Time: time.Date(2017, 4, 24, 9, 41, 34, 0, NoZone), Expect: "2017-04-24T09:41:34"},
+	}
+
+	for _, tc := range zoneCases {
+		t.Run(tc.Expect, func(t *testing.T) {
+			if s := Format(tc.Time, tc.Options); s != tc.Expect {
+				t.Errorf("Format = %q; want %q", s, tc.Expect)
+			}
+		})
+	}
+}
+
+func TestFormatYear(t *testing.T) {
+	var yearCases = []struct {
+		Year   int
+		Expect string
+	}{
+		{Year: 0, Expect: "0000"},
+		{Year: 33, Expect: "0033"},
+		{Year: 9999, Expect: "9999"},
+		{Year: 10000, Expect: "+10000"},
+		{Year: -1, Expect: "-0001"},
+		{Year: -12345, Expect: "-12345"},
+	}
+
+	for _, tc := range yearCases {
+		t.Run(tc.Expect, func(t *testing.T) {
+			d := time.Date(tc.Year, 1, 1, 0, 0, 0, 0, time.UTC)
+			if s := Format(d, FormatOptions{Precision: PrecisionYear}); s != tc.Expect {
+				t.Errorf("Format = %q; want %q", s, tc.Expect)
+			}
+		})
+	}
+}
+
+func TestFormatRFC3339Parity(t *testing.T) {
+	for _, d := range []time.Time{
+		time.Date(2017, 4, 24, 9, 41, 34, 0, time.UTC),
+		time.Date(2017, 4, 24, 9, 41, 34, 120, time.UTC),
+		time.Date(2017, 4, 24, 9, 41, 34, 502000000, time.FixedZone("", -3600)),
+		time.Date(1, 1, 1, 0, 0, 0, 999999999, time.FixedZone("", 5*3600+30*60)),
+	} {
+		if s, want := Format(d, FormatOptions{}), d.Format(time.RFC3339Nano); s != want {
+			t.Errorf("Format = %q; want %q", s, want)
+		}
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	d := time.Date(2020, 12, 31, 23, 59, 59, 123456789, time.FixedZone("", -(9*3600+30*60)))
+	for _, opts := range []FormatOptions{
+		{},
+		{Date: DateOrdinal},
+		{FixedFraction: true},
+		{Zone: ZoneFormatBasic},
+	} {
+		s := Format(d, opts)
+		n, err := ParseString(s)
+		if err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if !n.Equal(d) {
+			t.Errorf("%s: parsed as %s; want %s", s, n, d)
+		}
+	}
+}
+
+func TestAppendFormatAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, tc := range formatCases {
+			buf = AppendFormat(buf[:0], formatTime, tc.Options)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat allocated %v times; want 0", allocs)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendFormat(buf[:0], formatTime, FormatOptions{})
+	}
+}
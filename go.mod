@@ -1,3 +1,3 @@
 module github.com/relvacode/iso8601
 
-go 1.13
+go 1.18
@@ -0,0 +1,258 @@
+package iso8601
+
+import (
+	"encoding"
+	"encoding/json"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = Grouping{}
+	_ json.Unmarshaler         = &Grouping{}
+	_ encoding.TextMarshaler   = Grouping{}
+	_ encoding.TextUnmarshaler = &Grouping{}
+)
+
+// Sub-year grouping codes defined by ISO 8601-2.
+const (
+	// SpringNorthern to WinterNorthern are the seasons of the northern hemisphere.
+	SpringNorthern = 25 + iota
+	SummerNorthern
+	AutumnNorthern
+	WinterNorthern
+	// SpringSouthern to WinterSouthern are the seasons of the southern hemisphere.
+	SpringSouthern
+	SummerSouthern
+	AutumnSouthern
+	WinterSouthern
+	// Quarter1 to Quarter4 are the quarters of the year.
+	Quarter1
+	Quarter2
+	Quarter3
+	Quarter4
+	// Quadrimester1 to Quadrimester3 are the four month periods of the year.
+	Quadrimester1
+	Quadrimester2
+	Quadrimester3
+	// Semester1 and Semester2 are the halves of the year.
+	Semester1
+	Semester2
+)
+
+// Grouping is a sub-year grouping of ISO 8601-2, such as the quarter `2020-Q3` or the first semester `2020-40`.
+// Seasons are meteorological, so that a winter of the northern hemisphere starts in December of its year
+// and ends in February of the next.
+type Grouping struct {
+	Year int
+	// Code is the grouping code from 21 to 41. The codes 21 (spring) to 24 (winter) are the seasons
+	// of the northern hemisphere, like 25 to 28.
+	Code int
+}
+
+// groupingKinds are the first code and number of groupings of each kind.
+var groupingKinds = [...][2]int{{21, 4}, {SpringNorthern, 4}, {SpringSouthern, 4}, {Quarter1, 4}, {Quadrimester1, 3}, {Semester1, 2}}
+
+// groupingKind returns the first code and number of groupings of the kind of code.
+func groupingKind(code int) (int, int) {
+	for _, k := range groupingKinds {
+		if code >= k[0] && code < k[0]+k[1] {
+			return k[0], k[1]
+		}
+	}
+	return 0, 0
+}
+
+// GroupingOf returns the grouping of the same kind as code that contains t in its location.
+// For example GroupingOf(t, Quarter1) returns the quarter of t.
+// If code is not a valid grouping code then the zero Grouping is returned.
+func GroupingOf(t time.Time, code int) Grouping {
+	first, n := groupingKind(code)
+	for year := t.Year(); year >= t.Year()-1; year-- {
+		for c := first; c < first+n; c++ {
+			g := Grouping{Year: year, Code: c}
+			if start := g.Start(t.Location()); !t.Before(start) && t.Before(g.End(t.Location())) {
+				return g
+			}
+		}
+	}
+	return Grouping{}
+}
+
+// IsValid reports whether g has a valid grouping code.
+func (g Grouping) IsValid() bool {
+	_, _, ok := seasonMonths(g.Code)
+	return ok
+}
+
+// Quarter returns the quarter of the year from 1 to 4 if g is a quarter, or 0 otherwise.
+func (g Grouping) Quarter() int {
+	if g.Code < Quarter1 || g.Code > Quarter4 {
+		return 0
+	}
+	return g.Code - Quarter1 + 1
+}
+
+// Start returns the start of the first day of g in the given location.
+func (g Grouping) Start(loc *time.Location) time.Time {
+	month, _, _ := seasonMonths(g.Code)
+	return time.Date(g.Year, month, 1, 0, 0, 0, 0, loc)
+}
+
+// End returns the start of the day after the last day of g in the given location,
+// so that g covers the times from Start up to but excluding End.
+func (g Grouping) End(loc *time.Location) time.Time {
+	month, n, _ := seasonMonths(g.Code)
+	return time.Date(g.Year, month+time.Month(n), 1, 0, 0, 0, 0, loc)
+}
+
+// Next returns the grouping of the same kind that follows g, which may be in the next year.
+func (g Grouping) Next() Grouping {
+	return g.add(1)
+}
+
+// Prev returns the grouping of the same kind that precedes g, which may be in the previous year.
+func (g Grouping) Prev() Grouping {
+	return g.add(-1)
+}
+
+// add returns the grouping of the same kind n groupings after g.
+func (g Grouping) add(n int) Grouping {
+	first, count := groupingKind(g.Code)
+	if count == 0 {
+		return g
+	}
+	_, months, _ := seasonMonths(g.Code)
+	t := g.Start(time.UTC).AddDate(0, n*months, 0)
+	for c := first; c < first+count; c++ {
+		if month, _, _ := seasonMonths(c); month == t.Month() {
+			return Grouping{Year: t.Year(), Code: c}
+		}
+	}
+	return g
+}
+
+// String returns g in the format `YYYY-NN` of ISO 8601-2.
+func (g Grouping) String() string {
+	return string(g.AppendFormat(make([]byte, 0, 7), false))
+}
+
+// AppendFormat appends g to dst in the format `YYYY-NN` and returns the extended buffer.
+// If quarter is true then a quarter is appended in the format `YYYY-Qn` instead.
+func (g Grouping) AppendFormat(dst []byte, quarter bool) []byte {
+	dst = appendYear(dst, g.Year)
+	dst = append(dst, '-')
+	if q := g.Quarter(); quarter && q > 0 {
+		return append(dst, 'Q', byte('0'+q))
+	}
+	return appendInt(dst, g.Code, 2)
+}
+
+// MarshalText encodes g in the format `YYYY-NN`.
+func (g Grouping) MarshalText() ([]byte, error) {
+	return g.AppendFormat(nil, false), nil
+}
+
+// UnmarshalText decodes a grouping in the format `YYYY-NN` or `YYYY-Qn`.
+func (g *Grouping) UnmarshalText(b []byte) error {
+	var err error
+	*g, err = ParseGrouping(b)
+	return err
+}
+
+// MarshalJSON encodes g as a JSON string in the format `YYYY-NN`.
+func (g Grouping) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, 9), '"')
+	b = g.AppendFormat(b, false)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decodes a JSON string or null into a grouping.
+func (g *Grouping) UnmarshalJSON(b []byte) error {
+	// Do not process null types
+	if null(b) {
+		return nil
+	}
+	b, err := unquote(b)
+	if err != nil {
+		return err
+	}
+	return g.UnmarshalText(b)
+}
+
+// ParseGrouping parses an ISO 8601-2 sub-year grouping.
+// This function expects input that matches:
+//
+//	2020-35 (grouping code from 21 to 41)
+//	2020-Q3 (quarter from 1 to 4)
+//
+// If the grouping code or quarter is not within the expected range then an *iso8601.RangeError is returned.
+func ParseGrouping(inp []byte) (Grouping, error) {
+	return parseGrouping(inp)
+}
+
+// ParseGroupingString parses an ISO 8601-2 sub-year grouping string. See ParseGrouping for the accepted input.
+func ParseGroupingString(inp string) (Grouping, error) {
+	return parseGrouping(inp)
+}
+
+func parseGrouping[T input](inp T) (Grouping, error) {
+	var g Grouping
+	var i int
+
+	var neg bool
+	if len(inp) > 0 && (inp[0] == '+' || inp[0] == '-') {
+		neg = inp[0] == '-'
+		i++
+	}
+	start := i
+	for ; i < len(inp) && isDigit(inp[i]); i++ {
+		g.Year = g.Year*10 + int(inp[i]-'0')
+	}
+	if i-start < 4 {
+		return Grouping{}, unexpected(inp, i)
+	}
+	if neg {
+		g.Year = -g.Year
+	}
+	if i == len(inp) || inp[i] != '-' {
+		return Grouping{}, unexpected(inp, i)
+	}
+	i++
+
+	if i < len(inp) && inp[i] == 'Q' {
+		if i+1 == len(inp) || !isDigit(inp[i+1]) {
+			return Grouping{}, unexpected(inp, i+1)
+		}
+		q := int(inp[i+1] - '0')
+		if q < 1 || q > 4 {
+			return Grouping{}, &RangeError{
+				Value:   string(inp),
+				Element: "quarter",
+				Given:   q,
+				Min:     1,
+				Max:     4,
+			}
+		}
+		g.Code = Quarter1 + q - 1
+		i += 2
+	} else {
+		var err error
+		if g.Code, err = readDigits(inp, i, 2); err != nil {
+			return Grouping{}, err
+		}
+		i += 2
+		if !g.IsValid() {
+			return Grouping{}, &RangeError{
+				Value:   string(inp),
+				Element: "grouping",
+				Given:   g.Code,
+				Min:     21,
+				Max:     41,
+			}
+		}
+	}
+	if i < len(inp) {
+		return Grouping{}, newUnexpectedCharacterError(inp[i])
+	}
+	return g, nil
+}
@@ -0,0 +1,175 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseGrouping(t *testing.T) {
+	var cases = []struct {
+		Using  string
+		Expect Grouping
+		Format string
+		Start  time.Time
+		End    time.Time
+	}{
+		{Using: "2020-Q3", Expect: Grouping{Year: 2020, Code: Quarter3}, Format: "2020-35", Start: time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-33", Expect: Grouping{Year: 2020, Code: Quarter1}, Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-40", Expect: Grouping{Year: 2020, Code: Semester1}, Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-39", Expect: Grouping{Year: 2020, Code: Quadrimester3}, Start: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-21", Expect: Grouping{Year: 2020, Code: 21}, Start: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-28", Expect: Grouping{Year: 2020, Code: WinterNorthern}, Start: time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-30", Expect: Grouping{Year: 2020, Code: SummerSouthern}, Start: time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "2020-32", Expect: Grouping{Year: 2020, Code: WinterSouthern}, Start: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "-0044-Q1", Expect: Grouping{Year: -44, Code: Quarter1}, Format: "-0044-33", Start: time.Date(-44, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(-44, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			g, err := ParseGroupingString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g != c.Expect {
+				t.Errorf("ParseGrouping = %+v; want %+v", g, c.Expect)
+			}
+			format := c.Format
+			if format == "" {
+				format = c.Using
+			}
+			if s := g.String(); s != format {
+				t.Errorf("String = %q; want %q", s, format)
+			}
+			if s := g.Start(time.UTC); !s.Equal(c.Start) {
+				t.Errorf("Start = %s; want %s", s, c.Start)
+			}
+			if e := g.End(time.UTC); !e.Equal(c.End) {
+				t.Errorf("End = %s; want %s", e, c.End)
+			}
+			if b, err := ParseGrouping([]byte(c.Using)); err != nil || b != g {
+				t.Errorf("ParseGrouping = %+v, %v; want %+v", b, err, g)
+			}
+		})
+	}
+}
+
+func TestParseGroupingErrors(t *testing.T) {
+	var cases = []struct {
+		Using string
+		Err   error
+	}{
+		{Using: "", Err: ErrUnexpectedEnd},
+		{Using: "2020", Err: ErrUnexpectedEnd},
+		{Using: "2020-", Err: ErrUnexpectedEnd},
+		{Using: "2020-Q", Err: ErrUnexpectedEnd},
+		{Using: "20-Q1", Err: UnexpectedCharacterError{Character: '-'}},
+		{Using: "2020-Q5", Err: &RangeError{Element: "quarter", Given: 5}},
+		{Using: "2020-Q0", Err: &RangeError{Element: "quarter", Given: 0}},
+		{Using: "2020-20", Err: &RangeError{Element: "grouping", Given: 20}},
+		{Using: "2020-42", Err: &RangeError{Element: "grouping", Given: 42}},
+		{Using: "2020-07", Err: &RangeError{Element: "grouping", Given: 7}},
+		{Using: "2020-Q12", Err: UnexpectedCharacterError{Character: '2'}},
+		{Using: "2020/35", Err: UnexpectedCharacterError{Character: '/'}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			_, err := ParseGroupingString(c.Using)
+			if want, ok := c.Err.(*RangeError); ok {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || rangeErr.Element != want.Element || rangeErr.Given != want.Given {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				return
+			}
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+		})
+	}
+}
+
+func TestGroupingNavigation(t *testing.T) {
+	var cases = []struct {
+		Using Grouping
+		Next  Grouping
+	}{
+		{Using: Grouping{Year: 2020, Code: Quarter4}, Next: Grouping{Year: 2021, Code: Quarter1}},
+		{Using: Grouping{Year: 2020, Code: Quarter1}, Next: Grouping{Year: 2020, Code: Quarter2}},
+		{Using: Grouping{Year: 2020, Code: Semester2}, Next: Grouping{Year: 2021, Code: Semester1}},
+		{Using: Grouping{Year: 2020, Code: Quadrimester3}, Next: Grouping{Year: 2021, Code: Quadrimester1}},
+		{Using: Grouping{Year: 2020, Code: 24}, Next: Grouping{Year: 2021, Code: 21}},
+		{Using: Grouping{Year: 2020, Code: WinterNorthern}, Next: Grouping{Year: 2021, Code: SpringNorthern}},
+		{Using: Grouping{Year: 2020, Code: SpringSouthern}, Next: Grouping{Year: 2020, Code: SummerSouthern}},
+		{Using: Grouping{Year: 2020, Code: SummerSouthern}, Next: Grouping{Year: 2021, Code: AutumnSouthern}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using.String(), func(t *testing.T) {
+			if n := c.Using.Next(); n != c.Next {
+				t.Errorf("Next = %s; want %s", n, c.Next)
+			}
+			if p := c.Next.Prev(); p != c.Using {
+				t.Errorf("Prev = %s; want %s", p, c.Using)
+			}
+			if !c.Using.End(time.UTC).Equal(c.Next.Start(time.UTC)) {
+				t.Errorf("End = %s; want %s", c.Using.End(time.UTC), c.Next.Start(time.UTC))
+			}
+		})
+	}
+}
+
+func TestGroupingOf(t *testing.T) {
+	var cases = []struct {
+		Time   time.Time
+		Code   int
+		Expect Grouping
+	}{
+		{Time: time.Date(2020, 8, 15, 12, 0, 0, 0, time.UTC), Code: Quarter1, Expect: Grouping{Year: 2020, Code: Quarter3}},
+		{Time: time.Date(2020, 12, 31, 23, 59, 59, 0, time.UTC), Code: Quarter4, Expect: Grouping{Year: 2020, Code: Quarter4}},
+		{Time: time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), Code: Semester1, Expect: Grouping{Year: 2020, Code: Semester2}},
+		{Time: time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC), Code: 21, Expect: Grouping{Year: 2020, Code: 24}},
+		{Time: time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC), Code: SpringSouthern, Expect: Grouping{Year: 2020, Code: SummerSouthern}},
+		{Time: time.Date(2021, 2, 10, 0, 0, 0, 0, time.UTC), Code: 1, Expect: Grouping{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Expect.String(), func(t *testing.T) {
+			if g := GroupingOf(c.Time, c.Code); g != c.Expect {
+				t.Errorf("GroupingOf = %s; want %s", g, c.Expect)
+			}
+		})
+	}
+}
+
+func TestGroupingFormat(t *testing.T) {
+	g := Grouping{Year: 2020, Code: Quarter3}
+	if s := string(g.AppendFormat(nil, true)); s != "2020-Q3" {
+		t.Errorf("AppendFormat = %q; want %q", s, "2020-Q3")
+	}
+	g = Grouping{Year: 2020, Code: Semester2}
+	if s := string(g.AppendFormat(nil, true)); s != "2020-41" {
+		t.Errorf("AppendFormat = %q; want %q", s, "2020-41")
+	}
+}
+
+func TestGroupingJSON(t *testing.T) {
+	var v struct {
+		Period Grouping
+	}
+	if err := json.Unmarshal([]byte(`{"Period": "2020-Q3"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Period":"2020-35"}` {
+		t.Errorf("json.Marshal = %s", s)
+	}
+	if err := json.Unmarshal([]byte(`{"Period": 2020}`), &v); !errors.Is(err, ErrNotString) {
+		t.Errorf("expected to return error %v, got %v", ErrNotString, err)
+	}
+}
@@ -24,6 +24,27 @@ const (
 	charStart uint = '0'
 )
 
+// input is the type of data accepted by the parser.
+// Strings and byte slices are scanned directly, so that neither is copied to the other.
+type input interface {
+	~string | ~[]byte
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// UnknownOffset is the location of a time parsed with the RFC 3339 unknown local offset `-00:00` (or `-0000`, `-00`).
+// Such a time is in UTC, but the offset to the local time where it originated is unknown.
+// Compare a parsed time's Location with UnknownOffset to distinguish it from a time given in UTC.
+var UnknownOffset = time.FixedZone("-00:00", 0)
+
+// NoZone is the location of a time parsed without zone information by a profile that keeps it apart from UTC,
+// such as the XML Schema profiles, for which a value without a zone differs from one in UTC (`Z`).
+// It has a zero offset, and a time in NoZone is formatted without a zone.
+var NoZone = time.FixedZone("NoZone", 0)
+
 // ParseISOZone parses the 5 character zone information in an ISO8601 date string.
 // This function expects input that matches:
 //
@@ -35,64 +56,151 @@ const (
 //	+01
 //	+01:45
 //	+0145
+//
+// A negative zero offset (`-00:00`) returns the UnknownOffset location.
+// If the hour or minute of the offset is not within the expected range then an *iso8601.RangeError is returned.
 func ParseISOZone(inp []byte) (*time.Location, error) {
+	return parseISOZone(inp, &ParseOptions{})
+}
+
+func parseISOZone[T input](inp T, o *ParseOptions) (*time.Location, error) {
+	offset, kind, err := parseISOOffset(inp, o)
+	if err != nil {
+		return nil, err
+	}
+	return zoneLocation(offset, kind, o), nil
+}
+
+// ParseISOOffset parses the zone information in an ISO8601 date string without creating a *time.Location.
+// It accepts the same input as ParseISOZone and returns the offset in seconds east of UTC,
+// along with whether the input is the UTC designator (ZoneUTC), a numeric offset (ZoneOffset)
+// or the unknown local offset `-00:00` (ZoneUnknown).
+func ParseISOOffset(inp []byte) (seconds int, kind ZoneKind, err error) {
+	return parseISOOffset(inp, &ParseOptions{})
+}
+
+// maxZoneOffset is the largest magnitude of a zone offset accepted when ParseOptions.MaxZoneOffset is unset.
+const maxZoneOffset = 24*time.Hour - time.Second
+
+func parseISOOffset[T input](inp T, o *ParseOptions) (int, ZoneKind, error) {
 	if len(inp) == 0 {
-		return nil, ErrZoneCharacters
+		return 0, ZoneAbsent, ErrZoneCharacters
 	}
 
 	var neg bool
 	switch inp[0] {
 	case 'Z', 'z':
 		if len(inp) != 1 {
-			return nil, ErrRemainingData
+			return 0, ZoneAbsent, ErrRemainingData
 		}
-		return time.UTC, nil
+		return 0, ZoneUTC, nil
 	case '+':
 	case '-':
 		neg = true
 	default:
-		return nil, newUnexpectedCharacterError(inp[0])
+		return 0, ZoneAbsent, newUnexpectedCharacterError(inp[0])
 	}
 
-	if len(inp) < 3 || len(inp) > 6 {
-		return nil, ErrZoneCharacters
+	// The offset is made of two digit hour, minute and optionally second components.
+	// The colon separators are either used between all components (extended format) or not at all (basic format).
+	var components = 2
+	if o.AllowZoneSeconds {
+		components = 3
+	}
+	if len(inp) < 3 || len(inp) > 3*components {
+		return 0, ZoneAbsent, ErrZoneCharacters
 	}
 
-	var offset int
-
-	var z uint
-	var multiplier = uint(3600) // start with initial multiplier of hours
-	for i := 1; i < len(inp); i++ {
-		if i == 3 { // next multiplier
-			offset = int(z * multiplier)
-			multiplier = 60 // multiplier for minutes
-			z = 0
-		} else { // next digit
-			z = z * 10
+	var z [3]int
+	var n int // components accumulated so far
+	var extended bool
+	for i := 1; i < len(inp); {
+		if n == components {
+			return 0, ZoneAbsent, newUnexpectedCharacterError(inp[i])
 		}
-
-		switch inp[i] {
-		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-			z += uint(inp[i]) - charStart
-		case ':':
-			if i != 3 {
-				return nil, newUnexpectedCharacterError(inp[i])
+		if n > 0 {
+			switch {
+			case inp[i] == ':' && (n == 1 || extended):
+				extended = true
+				i++
+			case inp[i] == ':' || extended:
+				return 0, ZoneAbsent, newUnexpectedCharacterError(inp[i])
 			}
-		default:
-			return nil, newUnexpectedCharacterError(inp[i])
 		}
-
+		for j := 0; j < 2; j++ {
+			if i == len(inp) {
+				return 0, ZoneAbsent, ErrZoneCharacters
+			}
+			if inp[i] < '0' || inp[i] > '9' {
+				return 0, ZoneAbsent, newUnexpectedCharacterError(inp[i])
+			}
+			z[n] = z[n]*10 + int(inp[i]) - int(charStart)
+			i++
+		}
+		n++
 	}
 
-	offset += int(z * multiplier)
+	if err := checkZoneRange(inp, z[0], z[1], z[2], o); err != nil {
+		return 0, ZoneAbsent, err
+	}
 
+	offset := z[0]*3600 + z[1]*60 + z[2]
 	if neg {
 		offset = -offset
 	}
 	if neg && offset == 0 {
-		return nil, ErrInvalidZone
+		if o.RejectUnknownOffset {
+			return 0, ZoneAbsent, ErrInvalidZone
+		}
+		return 0, ZoneUnknown, nil
 	}
-	return time.FixedZone("", offset), nil
+	return offset, ZoneOffset, nil
+}
+
+// checkZoneRange validates the hour, minute and second of a zone offset against ParseOptions.MaxZoneOffset.
+func checkZoneRange[T input](inp T, h, m, s int, o *ParseOptions) error {
+	max := o.MaxZoneOffset
+	if max <= 0 || max > maxZoneOffset {
+		max = maxZoneOffset
+	}
+	maxSeconds := int(max / time.Second)
+	maxH, maxM, maxS := maxSeconds/3600, maxSeconds%3600/60, maxSeconds%60
+
+	switch {
+	case h > maxH:
+		return &RangeError{
+			Value:   string(inp),
+			Element: "zone hour",
+			Given:   h,
+			Min:     0,
+			Max:     maxH,
+		}
+	case m > 59 || (h == maxH && m > maxM):
+		limit := 59
+		if h == maxH {
+			limit = maxM
+		}
+		return &RangeError{
+			Value:   string(inp),
+			Element: "zone minute",
+			Given:   m,
+			Min:     0,
+			Max:     limit,
+		}
+	case s > 59 || (h == maxH && m == maxM && s > maxS):
+		limit := 59
+		if h == maxH && m == maxM {
+			limit = maxS
+		}
+		return &RangeError{
+			Value:   string(inp),
+			Element: "zone second",
+			Given:   s,
+			Min:     0,
+			Max:     limit,
+		}
+	}
+	return nil
 }
 
 // Parse parses an ISO8601 compliant date-time byte slice into a time.Time object.
@@ -104,6 +212,50 @@ func Parse(inp []byte) (time.Time, error) {
 // ParseInLocation parses an ISO8601 compliant date-time byte slice into a time.Time object.
 // If the input does not have timezone information, it will use the given location.
 func ParseInLocation(inp []byte, loc *time.Location) (time.Time, error) {
+	return parseInLocation(inp, loc, &ParseOptions{})
+}
+
+func parseInLocation[T input](inp T, loc *time.Location, o *ParseOptions) (time.Time, error) {
+	var f fields
+	if err := scan(inp, o, &f); err != nil {
+		return time.Time{}, err
+	}
+	if f.zone == ZoneAbsent {
+		if o.DST != DSTDefault {
+			return wallTime(f, loc, o.DST)
+		}
+		return time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, f.nanosecond, loc), nil
+	}
+
+	t := time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, f.nanosecond, zoneLocation(f.offset, f.zone, o))
+	if o.MatchLocationOffset && f.zone != ZoneUnknown {
+		if _, offset := t.In(loc).Zone(); offset != f.offset {
+			return time.Time{}, ErrOffsetMismatch
+		}
+	}
+	if o.ConvertToLocation || o.MatchLocationOffset {
+		t = t.In(loc)
+	}
+	return t, nil
+}
+
+// fields are the components of a date-time scanned from an ISO8601 input.
+type fields struct {
+	year, month, day                 int
+	hour, minute, second, nanosecond int
+
+	// precision is the smallest component given in the input.
+	precision Precision
+
+	zone ZoneKind
+	// offset is the zone offset in seconds east of UTC.
+	offset int
+}
+
+// scan scans and validates the components of an ISO8601 date-time into f,
+// with the date in the representation selected by ParseOptions.Date.
+// The fields are not returned by value, as copying them out of scan noticeably slows down Parse.
+func scan[T input](inp T, o *ParseOptions, f *fields) error {
 	var (
 		Y         uint
 		M         uint
@@ -118,10 +270,38 @@ func ParseInLocation(inp []byte, loc *time.Location) (time.Time, error) {
 	var c uint
 	var n uint // digits accumulated since the last separator
 	var p = year
-	var ordinal bool // input is an ISO 8601 ordinal date (YYYY-DDD)
+	var ordinal bool  // input is an ISO 8601 ordinal date (YYYY-DDD)
+	var negative bool // the year has a leading minus sign
 
+	var dated bool // the date was scanned into f by scanDate
+	var zoned bool // the input ended with a zone
 	var i int
 
+	switch o.Date {
+	case DateOmit:
+		// A time of day may be preceded by the time designator (`T15:04`).
+		if len(inp) > 0 && !o.Strict && (inp[0] == 'T' || (inp[0] == 't' && o.AllowLowercase)) {
+			i++
+		}
+		M = 1
+		d = 1
+		p = hour
+	case DateWeek, DateMonthDay:
+		var err error
+		i, err = scanDate(inp, f, o)
+		if err != nil {
+			return err
+		}
+		if i < len(inp) {
+			if err := checkSeparator(inp[i], o); err != nil {
+				return err
+			}
+			i++
+		}
+		dated = true
+		p = hour
+	}
+
 parse:
 	for ; i < len(inp); i++ {
 		switch inp[i] {
@@ -134,18 +314,25 @@ parse:
 				nfraction++
 			}
 		case '-':
-			if p < hour {
+			if i == 0 && p == year && o.AllowNegativeYear {
+				negative = true
+				continue
+			}
+			if p < hour && !dateZone(p, o) {
 				if n == 0 {
 					// A dash with no preceding digits (e.g. `2020--01`).
-					return time.Time{}, newUnexpectedCharacterError(inp[i])
+					return newUnexpectedCharacterError(inp[i])
+				}
+				if o.Strict && !strictWidth(p, n) {
+					return newUnexpectedCharacterError(inp[i])
 				}
-				switch p {
-				case year:
+				switch {
+				case p == year:
 					Y = c
-				case month:
+				case p == month && o.Date != DateOrdinal:
 					M = c
 				default:
-					return time.Time{}, newUnexpectedCharacterError(inp[i])
+					return newUnexpectedCharacterError(inp[i])
 				}
 				p++
 				c = 0
@@ -153,25 +340,48 @@ parse:
 				continue
 			}
 			fallthrough
-		case '+', 'Z':
-			if i == 0 {
+		case '+', 'Z', 'z':
+			if inp[i] == 'z' && !o.AllowLowercase {
+				return newUnexpectedCharacterError(inp[i])
+			}
+			if i == 0 && p == year {
 				// The ISO8601 technically allows signed year components.
-				// Go does not allow negative years, but let's allow a positive sign to be more compatible with the spec.
+				// A positive sign is allowed to be more compatible with the spec, and negative years with AllowNegativeYear.
 				// It must be the very first character of the input (#11).
+				if o.Strict {
+					return newUnexpectedCharacterError(inp[i])
+				}
 				continue
 			}
+			if o.RejectZone {
+				return newUnexpectedCharacterError(inp[i])
+			}
 
 			switch p {
 			case month:
-				// A three-digit component after the year is an ISO 8601 ordinal
-				// day-of-year (YYYY-DDD), not a month.
-				if n != 3 {
-					return time.Time{}, newUnexpectedCharacterError(inp[i])
+				switch {
+				case ordinalDay(n, o):
+					// A three-digit component after the year is an ISO 8601 ordinal
+					// day-of-year (YYYY-DDD), not a month.
+					M = 1
+					d = c
+					ordinal = true
+				case n == 2 && o.AllowDateZone && o.Date != DateOrdinal:
+					M = c
+					d = 1
+				default:
+					return newUnexpectedCharacterError(inp[i])
+				}
+			case day:
+				if !o.AllowDateZone || n == 0 {
+					return newUnexpectedCharacterError(inp[i])
 				}
-				M = 1
 				d = c
-				ordinal = true
 			case hour:
+				if n == 0 && o.Date == DateOmit {
+					// A time of day must be given before its zone.
+					return newUnexpectedCharacterError(inp[i])
+				}
 				h = c
 			case minute:
 				m = c
@@ -180,27 +390,45 @@ parse:
 			case millisecond:
 				fraction = int(c)
 			default:
-				return time.Time{}, newUnexpectedCharacterError(inp[i])
+				return newUnexpectedCharacterError(inp[i])
+			}
+			if o.Strict && !ordinal && !strictWidth(p, n) {
+				return newUnexpectedCharacterError(inp[i])
+			}
+			f.precision = precisionOf(p, nfraction-1)
+			if ordinal {
+				f.precision = PrecisionDay
+			}
+
+			z := inp[i:]
+			if o.Strict && len(z) > 1 && (len(z) < 6 || z[3] != ':') {
+				// Strict zone offsets are in the extended format with a minute (`+01:00`).
+				return ErrZoneCharacters
 			}
-			c = 0
-			n = 0
 			var err error
-			loc, err = ParseISOZone(inp[i:])
+			f.offset, f.zone, err = parseISOOffset(z, o)
 			if err != nil {
-				return time.Time{}, err
+				return err
 			}
+			zoned = true
 			break parse
-		case 'T', ' ':
+		case 'T', 't', ' ':
+			if err := checkSeparator(inp[i], o); err != nil {
+				return err
+			}
 			switch {
 			case p == day:
 				d = c
-			case p == month && n == 3:
+			case p == month && ordinalDay(n, o):
 				// ordinal day-of-year (YYYY-DDD) followed by a time
 				M = 1
 				d = c
 				ordinal = true
 			default:
-				return time.Time{}, newUnexpectedCharacterError(inp[i])
+				return newUnexpectedCharacterError(inp[i])
+			}
+			if o.Strict && !ordinal && !strictWidth(p, n) {
+				return newUnexpectedCharacterError(inp[i])
 			}
 			c = 0
 			n = 0
@@ -208,7 +436,10 @@ parse:
 		case ':':
 			if n == 0 {
 				// A colon with no preceding digits (e.g. `16::20`).
-				return time.Time{}, newUnexpectedCharacterError(inp[i])
+				return newUnexpectedCharacterError(inp[i])
+			}
+			if o.Strict && !strictWidth(p, n) {
+				return newUnexpectedCharacterError(inp[i])
 			}
 			switch p {
 			case hour:
@@ -217,57 +448,83 @@ parse:
 				m = c
 			default:
 				// A colon after the seconds field (e.g. `16:20:45:`) is invalid.
-				return time.Time{}, newUnexpectedCharacterError(inp[i])
+				return newUnexpectedCharacterError(inp[i])
 			}
 			c = 0
 			n = 0
 			p++
 		case '.':
-			if p != second || n == 0 {
-				return time.Time{}, newUnexpectedCharacterError(inp[i])
+			if p != second || n == 0 || (o.Strict && !strictWidth(p, n)) {
+				return newUnexpectedCharacterError(inp[i])
 			}
 			s = c
 			c = 0
 			n = 0
 			p++
 		default:
-			return time.Time{}, newUnexpectedCharacterError(inp[i])
+			return newUnexpectedCharacterError(inp[i])
 		}
 	}
 
 	// Capture remaining data
 	// Sometimes a date can end without a non-integer character
-	if p == month && n == 3 {
+	switch {
+	case zoned:
+	case p == month && ordinalDay(n, o):
 		// ISO 8601 ordinal date (YYYY-DDD): the three digits are the day of the
 		// year, not a month.
 		M = 1
 		d = c
 		ordinal = true
-	} else if c > 0 {
-		switch p {
-		case year:
-			Y = c
-			M = 1
-			d = 1
-		case month:
-			M = c
-			d = 1
-		case day:
-			d = c
-		case hour:
-			h = c
-		case minute:
-			m = c
-		case second:
-			s = c
-		case millisecond:
-			fraction = int(c)
+		f.precision = PrecisionDay
+	case n > 0:
+		if (o.Strict && !strictWidth(p, n)) || (p == month && o.Date == DateOrdinal) {
+			return ErrUnexpectedEnd
+		}
+		// Only non-zero values are captured, so that a trailing year of zero (e.g. `0000`) is left without a month and day.
+		if c > 0 {
+			switch p {
+			case year:
+				Y = c
+				M = 1
+				d = 1
+			case month:
+				M = c
+				d = 1
+			case day:
+				d = c
+			case hour:
+				h = c
+			case minute:
+				m = c
+			case second:
+				s = c
+			case millisecond:
+				fraction = int(c)
+			}
+		}
+		f.precision = precisionOf(p, nfraction-1)
+	case p == hour && o.Date != DateOmit:
+		// The input ended after the date, or after a separator that is not followed by a time.
+		if o.Strict && !isDigit(inp[i-1]) {
+			return ErrUnexpectedEnd
+		}
+		if !dated {
+			f.precision = PrecisionDay
+		}
+	default:
+		// The input is empty, or ends after a separator (e.g. `16:`).
+		if o.Strict || o.Date == DateOmit {
+			return ErrUnexpectedEnd
+		}
+		if p > year {
+			f.precision = precisionOf(p-1, 0)
 		}
 	}
 
 	// Get the seconds fraction as nanoseconds
 	if fraction < 0 || 1e9 <= fraction {
-		return time.Time{}, ErrPrecision
+		return ErrPrecision
 	}
 	scale := 10 - nfraction
 	for i := 0; i < scale; i++ {
@@ -275,8 +532,10 @@ parse:
 	}
 
 	switch {
+	case dated:
+		// The date was validated by scanDate.
 	case !ordinal && (M < 1 || M > 12): // Month 1-12
-		return time.Time{}, &RangeError{
+		return &RangeError{
 			Value:   string(inp),
 			Element: "month",
 			Given:   int(M),
@@ -284,7 +543,7 @@ parse:
 			Max:     12,
 		}
 	case ordinal && (d < 1 || int(d) > daysInYear(int(Y))): // Ordinal day 1-365/366
-		return time.Time{}, &RangeError{
+		return &RangeError{
 			Value:   string(inp),
 			Element: "day",
 			Given:   int(d),
@@ -292,15 +551,18 @@ parse:
 			Max:     daysInYear(int(Y)),
 		}
 	case !ordinal && (d < 1 || int(d) > daysIn(time.Month(M), int(Y))): // Day 1-daysIn(month, year)
-		return time.Time{}, &RangeError{
+		return &RangeError{
 			Value:   string(inp),
 			Element: "day",
 			Given:   int(d),
 			Min:     1,
 			Max:     daysIn(time.Month(M), int(Y)),
 		}
+	}
+
+	switch {
 	case h > 23: // Hour 0-23
-		return time.Time{}, &RangeError{
+		return &RangeError{
 			Value:   string(inp),
 			Element: "hour",
 			Given:   int(h),
@@ -308,7 +570,7 @@ parse:
 			Max:     23,
 		}
 	case m > 59: // Minute 0-59
-		return time.Time{}, &RangeError{
+		return &RangeError{
 			Value:   string(inp),
 			Element: "minute",
 			Given:   int(m),
@@ -316,7 +578,7 @@ parse:
 			Max:     59,
 		}
 	case s > 59: // Second 0-59
-		return time.Time{}, &RangeError{
+		return &RangeError{
 			Value:   string(inp),
 			Element: "second",
 			Given:   int(s),
@@ -325,16 +587,176 @@ parse:
 		}
 	}
 
-	return time.Date(int(Y), time.Month(M), int(d), int(h), int(m), int(s), fraction, loc), nil
+	if !dated {
+		f.year = int(Y)
+		if negative {
+			f.year = -f.year
+		}
+		f.month = int(M)
+		f.day = int(d)
+	}
+	f.hour = int(h)
+	f.minute = int(m)
+	f.second = int(s)
+	f.nanosecond = fraction
+
+	if o.Precisions != 0 && !o.Precisions.Contains(f.precision) {
+		return ErrUnsupportedPrecision
+	}
+	if o.RequireZone && f.precision <= PrecisionHour && f.zone == ZoneAbsent {
+		return ErrMissingZone
+	}
+	return nil
+}
+
+// scanDate scans a week date, or a month and day without a year, at the start of inp into f
+// as selected by ParseOptions.Date, and returns the position after it.
+func scanDate[T input](inp T, f *fields, o *ParseOptions) (int, error) {
+	if o.Date == DateWeek {
+		if len(inp) > 0 && ((inp[0] == '-' && !o.AllowNegativeYear) || (inp[0] == '+' && o.Strict)) {
+			return 0, newUnexpectedCharacterError(inp[0])
+		}
+		w, weekday, i, err := scanWeekDate(inp)
+		if err != nil {
+			return 0, err
+		}
+		f.year = w.Year
+		f.month = 1
+		f.day = weekDateDay(w.Year, w.Week, isoWeekday(w.Weekday))
+		f.precision = PrecisionMonth
+		if weekday {
+			f.precision = PrecisionDay
+		}
+		return i, nil
+	}
+
+	// A month and day is either truncated (`--MM-DD` or `--MMDD`) or given on its own (`MM-DD`).
+	var i int
+	truncated := len(inp) >= 2 && inp[0] == '-' && inp[1] == '-'
+	if truncated {
+		i = 2
+	}
+	month, err := readDigits(inp, i, 2)
+	if err != nil {
+		return 0, err
+	}
+	i += 2
+	switch {
+	case i < len(inp) && inp[i] == '-':
+		i++
+	case !truncated:
+		return 0, unexpected(inp, i)
+	}
+	day, err := readDigits(inp, i, 2)
+	if err != nil {
+		return 0, err
+	}
+	i += 2
+
+	// The year is taken to be 0, which is a leap year, so that the 29th of February is accepted.
+	switch {
+	case month < 1 || month > 12:
+		return 0, &RangeError{
+			Value:   string(inp),
+			Element: "month",
+			Given:   month,
+			Min:     1,
+			Max:     12,
+		}
+	case day < 1 || day > daysIn(time.Month(month), 0):
+		return 0, &RangeError{
+			Value:   string(inp),
+			Element: "day",
+			Given:   day,
+			Min:     1,
+			Max:     daysIn(time.Month(month), 0),
+		}
+	}
+	f.month = month
+	f.day = day
+	f.precision = PrecisionDay
+	return i, nil
+}
+
+// checkSeparator returns an error if c is not a separator between a date and time accepted by the options.
+func checkSeparator(c byte, o *ParseOptions) error {
+	switch {
+	case c == 'T':
+	case c == 't' && o.AllowLowercase:
+	case c == ' ' && !o.RejectSpaceSeparator:
+	default:
+		return newUnexpectedCharacterError(c)
+	}
+	return nil
+}
+
+// dateZone reports whether a `-` following the date component p is the sign of a zone offset
+// rather than a separator between date components.
+// A year and month followed by a negative offset (`2020-01-05:00`) cannot be told apart from a date,
+// so it is only a zone if ParseOptions.Precisions does not accept a day.
+func dateZone(p uint, o *ParseOptions) bool {
+	if !o.AllowDateZone {
+		return false
+	}
+	switch p {
+	case day:
+		return true
+	case month:
+		return o.Precisions != 0 && !o.Precisions.Contains(PrecisionDay)
+	}
+	return false
+}
+
+// ordinalDay reports whether a component of n digits after the year is an ordinal day-of-year.
+// ParseOptions.Strict only accepts ordinal dates with DateOrdinal.
+func ordinalDay(n uint, o *ParseOptions) bool {
+	return n == 3 && (!o.Strict || o.Date == DateOrdinal)
+}
+
+// strictWidth reports whether n digits are accepted for the component p by ParseOptions.Strict.
+func strictWidth(p uint, n uint) bool {
+	switch p {
+	case year:
+		return n >= 4
+	case millisecond:
+		return n >= 1
+	}
+	return n == 2
+}
+
+// precisionOf returns the precision of input ending with the component p,
+// where digits is the number of digits given for the fraction of a second.
+func precisionOf(p uint, digits int) Precision {
+	switch p {
+	case year:
+		return PrecisionYear
+	case month:
+		return PrecisionMonth
+	case day:
+		return PrecisionDay
+	case hour:
+		return PrecisionHour
+	case minute:
+		return PrecisionMinute
+	case second:
+		return PrecisionSecond
+	}
+	switch {
+	case digits <= 3:
+		return PrecisionMillisecond
+	case digits <= 6:
+		return PrecisionMicrosecond
+	}
+	return PrecisionNanosecond
 }
 
 // ParseString parses an ISO8601 compliant date-time string into a time.Time object.
 func ParseString(inp string) (time.Time, error) {
-	return Parse([]byte(inp))
+	return parseInLocation(inp, time.UTC, &ParseOptions{})
 }
 
 // ParseStringInLocation parses an ISO8601 compliant date-time string into a time.Time object.
 // If the input does not have timezone information, it will use the given location.
 func ParseStringInLocation(inp string, loc *time.Location) (time.Time, error) {
-	return ParseInLocation([]byte(inp), loc)
+	return parseInLocation(inp, loc, &ParseOptions{})
 }
@@ -313,19 +313,30 @@ var cases = []TestCase{
 		Zone:        0,
 	},
 
-	// Invalid Parse Test Cases
+	// RFC 3339 unknown local offset
 	{
-		Using:           "2017-04-24T09:41:34.502-00",
-		ShouldFailParse: true,
+		Using: "2017-04-24T09:41:34.502-00",
+		Year:  2017, Month: 4, Day: 24,
+		Hour: 9, Minute: 41, Second: 34,
+		MilliSecond: 502,
+		Zone:        0,
 	},
 	{
-		Using:           "2017-04-24T09:41:34.502-0000",
-		ShouldFailParse: true,
+		Using: "2017-04-24T09:41:34.502-0000",
+		Year:  2017, Month: 4, Day: 24,
+		Hour: 9, Minute: 41, Second: 34,
+		MilliSecond: 502,
+		Zone:        0,
 	},
 	{
-		Using:           "2017-04-24T09:41:34.502-00:00",
-		ShouldFailParse: true,
+		Using: "2017-04-24T09:41:34.502-00:00",
+		Year:  2017, Month: 4, Day: 24,
+		Hour: 9, Minute: 41, Second: 34,
+		MilliSecond: 502,
+		Zone:        0,
 	},
+
+	// Invalid Parse Test Cases
 	{
 		Using:           "-2017-04-24T09:41:34.502-00:00",
 		ShouldFailParse: true,
@@ -346,8 +357,22 @@ var cases = []TestCase{
 		Using:           "2017-01-01T00:00:60.000Z00:00",
 		ShouldFailParse: true,
 	},
+	{
+		Using:           "z2017-04-24",
+		ShouldFailParse: true,
+	},
 
 	// Invalid Range Test Cases
+	{
+		Using:                   "0",
+		ShouldInvalidRange:      true,
+		RangeElementWhenInvalid: "month",
+	},
+	{
+		Using:                   "0000",
+		ShouldInvalidRange:      true,
+		RangeElementWhenInvalid: "month",
+	},
 	{
 		Using:                   "2017-00-01T00:00:00.000+00:00",
 		ShouldInvalidRange:      true,
@@ -440,6 +465,76 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkParseString(b *testing.B) {
+	for _, x := range []string{
+		"2017-04-24T09:41:34.502Z",
+		"2017-04-24T09:41:34.502123456+02:00",
+	} {
+		b.Run(x, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := ParseString(x)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	for _, s := range []string{
+		"2017-04-24T09:41:34.502Z",
+		"2017-04-24T09:41:34.502123456+02:00",
+	} {
+		x := []byte(s)
+		b.Run(s, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := Parse(x)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseStringAllocs(t *testing.T) {
+	// Longer than the stack buffer Go uses for short string to byte slice conversions.
+	const s = "2017-04-24T09:41:34.502123456+02:00"
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := ParseString(s); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseStringInLocation(s, time.UTC); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseString(%q) allocated %v times; want 0", s, allocs)
+	}
+}
+
+func BenchmarkParseOffset(b *testing.B) {
+	for _, s := range []string{
+		"2017-04-24T09:41:34.502+02:00",
+		"2017-04-24T09:41:34.502-0530",
+		"2017-04-24T09:41:34.502+00:00",
+	} {
+		x := []byte(s)
+		b.Run(s, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := Parse(x)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 func TestParseStringInLocation(t *testing.T) {
 	cases := []TestCase{
 		{
@@ -510,8 +605,8 @@ func TestParseISOZone(t *testing.T) {
 			Expect: UnexpectedCharacterError{Character: '0'},
 		},
 		{
-			Using:  "-00:00",
-			Expect: ErrInvalidZone,
+			Using: "-00:00",
+			Zone:  0,
 		},
 		{
 			Using: "-05:30",
@@ -537,6 +632,18 @@ func TestParseISOZone(t *testing.T) {
 			Using: "-01",
 			Zone:  -1,
 		},
+		{
+			Using:  "+01:",
+			Expect: ErrZoneCharacters,
+		},
+		{
+			Using:  "+010",
+			Expect: ErrZoneCharacters,
+		},
+		{
+			Using:  "+01:0",
+			Expect: ErrZoneCharacters,
+		},
 	}
 
 	for _, tc := range zoneTestCases {
@@ -560,3 +667,169 @@ func TestParseISOZone(t *testing.T) {
 		})
 	}
 }
+
+func TestUnknownOffset(t *testing.T) {
+	for _, s := range []string{"-00:00", "-0000", "-00"} {
+		t.Run(s, func(t *testing.T) {
+			z, err := ParseISOZone([]byte(s))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if z != UnknownOffset {
+				t.Errorf("ParseISOZone(%q) = %v; want UnknownOffset", s, z)
+			}
+
+			d, err := ParseString("2017-04-24T09:41:34" + s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Location() != UnknownOffset {
+				t.Errorf("Location = %v; want UnknownOffset", d.Location())
+			}
+			if !d.Equal(time.Date(2017, 4, 24, 9, 41, 34, 0, time.UTC)) {
+				t.Errorf("Time = %s; want 2017-04-24T09:41:34Z", d)
+			}
+
+			opts := ParseOptions{RejectUnknownOffset: true}
+			if _, err := opts.ParseISOZone([]byte(s)); !errors.Is(err, ErrInvalidZone) {
+				t.Errorf("ParseISOZone with RejectUnknownOffset returned %v; want %v", err, ErrInvalidZone)
+			}
+			if _, err := opts.ParseString("2017-04-24T09:41:34" + s); !errors.Is(err, ErrInvalidZone) {
+				t.Errorf("ParseString with RejectUnknownOffset returned %v; want %v", err, ErrInvalidZone)
+			}
+		})
+	}
+
+	d, err := ParseString("2017-04-24T09:41:34+00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Location() == UnknownOffset {
+		t.Error("A positive zero offset must not use the UnknownOffset location")
+	}
+}
+
+func TestParseISOZoneRange(t *testing.T) {
+	var rangeCases = []struct {
+		Using   string
+		Options ParseOptions
+		Element string
+		Max     int
+	}{
+		{Using: "+99:99", Element: "zone hour", Max: 23},
+		{Using: "+24:00", Element: "zone hour", Max: 23},
+		{Using: "-2400", Element: "zone hour", Max: 23},
+		{Using: "+23:60", Element: "zone minute", Max: 59},
+		{Using: "+15:00", Options: ParseOptions{MaxZoneOffset: 14 * time.Hour}, Element: "zone hour", Max: 14},
+		{Using: "+14:30", Options: ParseOptions{MaxZoneOffset: 14 * time.Hour}, Element: "zone minute", Max: 0},
+		{Using: "+00:09:60", Options: ParseOptions{AllowZoneSeconds: true}, Element: "zone second", Max: 59},
+	}
+
+	for _, tc := range rangeCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			_, err := tc.Options.ParseISOZone([]byte(tc.Using))
+			var re *RangeError
+			if !errors.As(err, &re) {
+				t.Fatalf("Found error %v of type %T but was expecting a RangeError", err, err)
+			}
+			if re.Element != tc.Element || re.Max != tc.Max {
+				t.Errorf("Expected a range error on %q with max %d but encountered %q with max %d", tc.Element, tc.Max, re.Element, re.Max)
+			}
+		})
+	}
+
+	t.Run("in date-time", func(t *testing.T) {
+		_, err := ParseString("2017-04-24T09:41:34+99:99")
+		var re *RangeError
+		if !errors.As(err, &re) || re.Element != "zone hour" {
+			t.Errorf("Expected a range error on %q, got %v", "zone hour", err)
+		}
+	})
+
+	t.Run("max offset", func(t *testing.T) {
+		opts := ParseOptions{MaxZoneOffset: 14 * time.Hour}
+		for _, s := range []string{"+14:00", "-14:00", "+13:59", "+05:45"} {
+			if _, err := opts.ParseISOZone([]byte(s)); err != nil {
+				t.Errorf("%s: %v", s, err)
+			}
+		}
+	})
+}
+
+func TestParseISOZoneSeconds(t *testing.T) {
+	var secondsCases = []struct {
+		Using  string
+		Offset int
+		Expect error
+	}{
+		{Using: "+00:09:21", Offset: 9*60 + 21},
+		{Using: "+000921", Offset: 9*60 + 21},
+		{Using: "-00:01:15", Offset: -75},
+		{Using: "+01:00", Offset: 3600},
+		{Using: "+0100", Offset: 3600},
+		{Using: "+00:0921", Expect: UnexpectedCharacterError{Character: '2'}},
+		{Using: "+0009:21", Expect: UnexpectedCharacterError{Character: ':'}},
+		{Using: "+00:09:2", Expect: ErrZoneCharacters},
+		{Using: "+00:09:21:00", Expect: ErrZoneCharacters},
+	}
+
+	opts := ParseOptions{AllowZoneSeconds: true}
+	for _, tc := range secondsCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			z, err := opts.ParseISOZone([]byte(tc.Using))
+			if !errors.Is(err, tc.Expect) {
+				t.Fatalf("ParseISOZone expected to return error %v (%T), got %v (%T)", tc.Expect, tc.Expect, err, err)
+			}
+			if tc.Expect != nil {
+				return
+			}
+			if _, offset := time.Date(1890, 1, 1, 0, 0, 0, 0, z).Zone(); offset != tc.Offset {
+				t.Errorf("ParseISOZone expected to return offset %d, got %d", tc.Offset, offset)
+			}
+		})
+	}
+
+	if _, err := ParseISOZone([]byte("+00:09:21")); !errors.Is(err, ErrZoneCharacters) {
+		t.Errorf("Expected seconds to be rejected by default, got %v", err)
+	}
+
+	d, err := opts.ParseString("1890-01-01T00:00:00+00:09:21")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Equal(time.Date(1889, 12, 31, 23, 50, 39, 0, time.UTC)) {
+		t.Errorf("Time = %s; want 1889-12-31T23:50:39Z", d.UTC())
+	}
+}
+
+func TestParseDateForm(t *testing.T) {
+	var formCases = []struct {
+		Date   DateForm
+		Using  string
+		Expect time.Time
+		Err    error
+	}{
+		{Date: DateWeek, Using: "2020-W53-5T10:00:00Z", Expect: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Date: DateWeek, Using: "2020W535", Expect: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Date: DateWeek, Using: "2020-W53x", Err: UnexpectedCharacterError{Character: 'x'}},
+		{Date: DateOrdinal, Using: "2020-366T23:59Z", Expect: time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC)},
+		{Date: DateOrdinal, Using: "2020-12-31", Err: UnexpectedCharacterError{Character: '-'}},
+		{Date: DateOrdinal, Using: "2020-12", Err: ErrUnexpectedEnd},
+		{Date: DateMonthDay, Using: "--1231T23:59", Expect: time.Date(0, 12, 31, 23, 59, 0, 0, time.UTC)},
+		{Date: DateOmit, Using: "T23:59:59.5+01:00", Expect: time.Date(0, 1, 1, 23, 59, 59, 500000000, time.FixedZone("", 3600))},
+		{Date: DateOmit, Using: "Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Date: DateOmit, Using: "2020-01-01", Err: UnexpectedCharacterError{Character: '-'}},
+	}
+
+	for _, tc := range formCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			d, err := ParseOptions{Date: tc.Date}.ParseString(tc.Using)
+			if !errors.Is(err, tc.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", tc.Err, tc.Err, err, err)
+			}
+			if tc.Err == nil && !d.Equal(tc.Expect) {
+				t.Errorf("ParseString = %s; want %s", d, tc.Expect)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package iso8601
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 )
@@ -71,7 +72,7 @@ func TestTime_UnmarshalJSON(t *testing.T) {
 		}
 
 		err := tn.UnmarshalJSON([]byte(`2001-11-13`))
-		if err != ErrNotString {
+		if !errors.Is(err, ErrNotString) {
 			t.Fatal(err)
 		}
 		if err == nil {
@@ -148,6 +149,91 @@ func TestTime_UnmarshalJSON(t *testing.T) {
 	})
 }
 
+func TestTime_UnmarshalJSONString(t *testing.T) {
+	var cases = []struct {
+		Using  string
+		Expect time.Time
+	}{
+		{Using: `"2020-01-01T00:00:00\u002B01:00"`, Expect: time.Date(2019, 12, 31, 23, 0, 0, 0, time.UTC)},
+		{Using: `"2020\u002d01\u002D01"`, Expect: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: `"2020-01-01T00:00:00\/"`},
+		{Using: " \t\"2020-01-01\"\r\n", Expect: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			var tn Time
+			err := tn.UnmarshalJSON([]byte(c.Using))
+			if c.Expect.IsZero() {
+				if err == nil {
+					t.Fatal("Expected an error from unmarshal")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tn.Equal(c.Expect) {
+				t.Errorf("UnmarshalJSON = %s; want %s", tn.Time, c.Expect)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalJSONType(t *testing.T) {
+	var cases = []struct {
+		Using string
+		Type  string
+	}{
+		{Using: `20200101`, Type: "number"},
+		{Using: `-1`, Type: "number"},
+		{Using: `true`, Type: "boolean"},
+		{Using: `{}`, Type: "object"},
+		{Using: `["2020-01-01"]`, Type: "array"},
+		{Using: `"2020-01-01`, Type: "invalid string"},
+		{Using: `"2020-01-01\x"`, Type: "invalid string"},
+		{Using: `"2020"-01-01"`, Type: "invalid string"},
+		{Using: "\"2020-01-01\n\"", Type: "invalid string"},
+		{Using: "\"2020-01-01\t\"", Type: "invalid string"},
+		{Using: `nulls`, Type: "invalid"},
+		{Using: ` `, Type: "empty"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			var tn Time
+			err := tn.UnmarshalJSON([]byte(c.Using))
+			if !errors.Is(err, ErrNotString) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", ErrNotString, ErrNotString, err, err)
+			}
+			var typeErr *JSONTypeError
+			if !errors.As(err, &typeErr) || typeErr.Type != c.Type {
+				t.Errorf("Type = %v; want %s", err, c.Type)
+			}
+		})
+	}
+}
+
+func TestNull(t *testing.T) {
+	var cases = []struct {
+		Using  string
+		Expect bool
+	}{
+		{Using: `null`, Expect: true},
+		{Using: " null\n", Expect: true},
+		{Using: `nulL`},
+		{Using: `xull`},
+		{Using: `"ab"`},
+		{Using: `nul`},
+	}
+
+	for _, c := range cases {
+		if got := null([]byte(c.Using)); got != c.Expect {
+			t.Errorf("null(%q) = %t; want %t", c.Using, got, c.Expect)
+		}
+	}
+}
+
 func BenchmarkCheckNull(b *testing.B) {
 	var n = []byte("null")
 
@@ -0,0 +1,158 @@
+package iso8601
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = LocalDateTime{}
+	_ json.Unmarshaler         = &LocalDateTime{}
+	_ encoding.TextMarshaler   = LocalDateTime{}
+	_ encoding.TextUnmarshaler = &LocalDateTime{}
+	_ driver.Valuer            = LocalDateTime{}
+	_ sql.Scanner              = &LocalDateTime{}
+)
+
+// localOptions parses a local date and time, which never has zone information.
+var localOptions = ParseOptions{RejectZone: true}
+
+// LocalDateTime is a date and time of day without zone information, such as `2020-06-01T09:00:00`,
+// which is a wall clock reading rather than an instant in time.
+// It is encoded in JSON, text and SQL without a zone, and is only converted to an instant by In.
+type LocalDateTime struct {
+	Year       int
+	Month      time.Month
+	Day        int
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// LocalDateTimeOf returns the wall clock reading of t in its location.
+func LocalDateTimeOf(t time.Time) LocalDateTime {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return LocalDateTime{
+		Year:       year,
+		Month:      month,
+		Day:        day,
+		Hour:       hour,
+		Minute:     min,
+		Second:     sec,
+		Nanosecond: t.Nanosecond(),
+	}
+}
+
+// In returns the instant at which the wall clock reads l in the given location.
+// As with time.Date, a wall clock reading that is skipped or repeated by a daylight saving time transition
+// is normalized to one of the instants around the transition.
+func (l LocalDateTime) In(loc *time.Location) time.Time {
+	return time.Date(l.Year, l.Month, l.Day, l.Hour, l.Minute, l.Second, l.Nanosecond, loc)
+}
+
+// IsZero reports whether l is the zero value.
+func (l LocalDateTime) IsZero() bool {
+	return l == LocalDateTime{}
+}
+
+// Before reports whether the wall clock reading l is before u.
+func (l LocalDateTime) Before(u LocalDateTime) bool {
+	return l.In(time.UTC).Before(u.In(time.UTC))
+}
+
+// After reports whether the wall clock reading l is after u.
+func (l LocalDateTime) After(u LocalDateTime) bool {
+	return l.In(time.UTC).After(u.In(time.UTC))
+}
+
+// String returns l in the extended format without a zone, such as `2020-06-01T09:00:00.5`.
+func (l LocalDateTime) String() string {
+	return string(l.AppendFormat(make([]byte, 0, 32)))
+}
+
+// AppendFormat appends l in the extended format without a zone to dst and returns the extended buffer.
+func (l LocalDateTime) AppendFormat(dst []byte) []byte {
+	return AppendFormat(dst, l.In(time.UTC), FormatOptions{Zone: ZoneFormatOmit})
+}
+
+// MarshalText encodes l in the extended format without a zone.
+func (l LocalDateTime) MarshalText() ([]byte, error) {
+	return l.AppendFormat(nil), nil
+}
+
+// UnmarshalText decodes a date and time without zone information.
+func (l *LocalDateTime) UnmarshalText(b []byte) error {
+	var err error
+	*l, err = ParseLocalDateTime(b)
+	return err
+}
+
+// MarshalJSON encodes l as a JSON string in the extended format without a zone.
+func (l LocalDateTime) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, 34), '"')
+	b = l.AppendFormat(b)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decodes a JSON string or null into a local date and time.
+func (l *LocalDateTime) UnmarshalJSON(b []byte) error {
+	// Do not process null types
+	if null(b) {
+		return nil
+	}
+	b, err := unquote(b)
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalText(b)
+}
+
+// Value implements driver.Valuer by encoding l as a string in the extended format without a zone.
+func (l LocalDateTime) Value() (driver.Value, error) {
+	return l.String(), nil
+}
+
+// Scan implements sql.Scanner by decoding a local date and time from a string or byte slice.
+// A time.Time, as returned by drivers for columns without a zone, is decoded as its wall clock reading in its location.
+func (l *LocalDateTime) Scan(src interface{}) error {
+	var err error
+	switch v := src.(type) {
+	case string:
+		*l, err = ParseLocalDateTimeString(v)
+	case []byte:
+		*l, err = ParseLocalDateTime(v)
+	case time.Time:
+		*l = LocalDateTimeOf(v)
+	default:
+		return fmt.Errorf("iso8601: Cannot scan %T into a local date and time", src)
+	}
+	return err
+}
+
+// ParseLocalDateTime parses an ISO8601 date and time without zone information, such as `2020-06-01T09:00`.
+// It accepts the same input as Parse, except that input with zone information returns an UnexpectedCharacterError.
+func ParseLocalDateTime(inp []byte) (LocalDateTime, error) {
+	return parseLocalDateTime(inp)
+}
+
+// ParseLocalDateTimeString parses an ISO8601 date and time string without zone information.
+// See ParseLocalDateTime for the accepted input.
+func ParseLocalDateTimeString(inp string) (LocalDateTime, error) {
+	return parseLocalDateTime(inp)
+}
+
+func parseLocalDateTime[T input](inp T) (LocalDateTime, error) {
+	var f fields
+	if err := scan(inp, &localOptions, &f); err != nil {
+		return LocalDateTime{}, err
+	}
+	// Ordinal dates are normalized to a month and day in the same way as by Parse.
+	t := time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, f.nanosecond, time.UTC)
+	return LocalDateTimeOf(t), nil
+}
@@ -0,0 +1,128 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLocalDateTime(t *testing.T) {
+	var cases = []struct {
+		Using  string
+		Expect LocalDateTime
+		Format string
+	}{
+		{Using: "2020-06-01T09:00", Expect: LocalDateTime{Year: 2020, Month: time.June, Day: 1, Hour: 9}, Format: "2020-06-01T09:00:00"},
+		{Using: "2020-06-01T09:00:00.5", Expect: LocalDateTime{Year: 2020, Month: time.June, Day: 1, Hour: 9, Nanosecond: 500000000}, Format: "2020-06-01T09:00:00.5"},
+		{Using: "2020-06-01", Expect: LocalDateTime{Year: 2020, Month: time.June, Day: 1}, Format: "2020-06-01T00:00:00"},
+		{Using: "2020-153T23:59:59", Expect: LocalDateTime{Year: 2020, Month: time.June, Day: 1, Hour: 23, Minute: 59, Second: 59}, Format: "2020-06-01T23:59:59"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			l, err := ParseLocalDateTimeString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l != c.Expect {
+				t.Errorf("ParseLocalDateTime = %+v; want %+v", l, c.Expect)
+			}
+			if s := l.String(); s != c.Format {
+				t.Errorf("String = %q; want %q", s, c.Format)
+			}
+			if b, err := ParseLocalDateTime([]byte(c.Using)); err != nil || b != l {
+				t.Errorf("ParseLocalDateTime = %+v, %v; want %+v", b, err, l)
+			}
+		})
+	}
+}
+
+func TestParseLocalDateTimeErrors(t *testing.T) {
+	var cases = []struct {
+		Using string
+		Err   error
+	}{
+		{Using: "2020-06-01T09:00Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Using: "2020-06-01T09:00+01:00", Err: UnexpectedCharacterError{Character: '+'}},
+		{Using: "2020-06-31T09:00", Err: &RangeError{Element: "day", Given: 31}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			_, err := ParseLocalDateTimeString(c.Using)
+			if want, ok := c.Err.(*RangeError); ok {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || rangeErr.Element != want.Element || rangeErr.Given != want.Given {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				return
+			}
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeIn(t *testing.T) {
+	l := LocalDateTime{Year: 2020, Month: time.June, Day: 1, Hour: 9}
+	loc := time.FixedZone("", -4*3600)
+	if d := l.In(loc); !d.Equal(time.Date(2020, 6, 1, 13, 0, 0, 0, time.UTC)) || d.Location() != loc {
+		t.Errorf("In = %s", d)
+	}
+	if got := LocalDateTimeOf(l.In(loc)); got != l {
+		t.Errorf("LocalDateTimeOf = %+v; want %+v", got, l)
+	}
+	later := LocalDateTime{Year: 2020, Month: time.June, Day: 1, Hour: 10}
+	if !l.Before(later) || l.After(later) || !later.After(l) {
+		t.Errorf("expected %s to be before %s", l, later)
+	}
+	if l.IsZero() || !(LocalDateTime{}).IsZero() {
+		t.Error("IsZero")
+	}
+}
+
+func TestLocalDateTime_JSON(t *testing.T) {
+	var v struct {
+		Start LocalDateTime
+	}
+	if err := json.Unmarshal([]byte(`{"Start": "2020-06-01T09:00"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Start":"2020-06-01T09:00:00"}` {
+		t.Errorf("json.Marshal = %s", s)
+	}
+	if err := json.Unmarshal([]byte(`{"Start": "2020-06-01T09:00:00Z"}`), &v); !errors.Is(err, UnexpectedCharacterError{Character: 'Z'}) {
+		t.Errorf("expected to return an error for a zone, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"Start": 1}`), &v); !errors.Is(err, ErrNotString) {
+		t.Errorf("expected to return error %v, got %v", ErrNotString, err)
+	}
+}
+
+func TestLocalDateTime_SQL(t *testing.T) {
+	l := LocalDateTime{Year: 2020, Month: time.June, Day: 1, Hour: 9, Minute: 30}
+	v, err := l.Value()
+	if err != nil || v != "2020-06-01T09:30:00" {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+
+	var d LocalDateTime
+	if err := d.Scan("2020-06-01T09:30:00"); err != nil || d != l {
+		t.Errorf("Scan(string) = %+v, %v", d, err)
+	}
+	if err := d.Scan([]byte("2020-06-01T09:30")); err != nil || d != l {
+		t.Errorf("Scan([]byte) = %+v, %v", d, err)
+	}
+	if err := d.Scan(time.Date(2020, 6, 1, 9, 30, 0, 0, time.FixedZone("", 3600))); err != nil || d != l {
+		t.Errorf("Scan(time.Time) = %+v, %v", d, err)
+	}
+	if err := d.Scan(int64(1)); err == nil {
+		t.Error("Expected an error scanning an integer")
+	}
+}
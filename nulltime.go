@@ -0,0 +1,150 @@
+package iso8601
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = NullTime{}
+	_ json.Unmarshaler         = &NullTime{}
+	_ encoding.TextMarshaler   = NullTime{}
+	_ encoding.TextUnmarshaler = &NullTime{}
+	_ driver.Valuer            = NullTime{}
+	_ sql.Scanner              = &NullTime{}
+
+	_ json.Marshaler           = OptionalTime{}
+	_ json.Unmarshaler         = &OptionalTime{}
+	_ encoding.TextUnmarshaler = &OptionalTime{}
+)
+
+// NullTime is an ISO8601 time that may be null, like sql.NullTime.
+// It is encoded as a JSON string in the same format as time.RFC3339Nano, or null if it is not valid.
+type NullTime struct {
+	Time time.Time
+	// Valid is true if Time is not null.
+	Valid bool
+}
+
+// NewNullTime returns a valid NullTime of t.
+func NewNullTime(t time.Time) NullTime {
+	return NullTime{Time: t, Valid: true}
+}
+
+// MarshalText encodes the time in the same format as time.RFC3339Nano, or as empty text if it is not valid.
+func (n NullTime) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return AppendFormat(nil, n.Time, FormatOptions{}), nil
+}
+
+// UnmarshalText decodes an ISO8601 time, or a null time from empty text.
+func (n *NullTime) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*n = NullTime{}
+		return nil
+	}
+	t, err := Parse(b)
+	if err != nil {
+		return err
+	}
+	*n = NewNullTime(t)
+	return nil
+}
+
+// MarshalJSON encodes the time as a JSON string in the same format as time.RFC3339Nano, or as null if it is not valid.
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	b := append(make([]byte, 0, 37), '"')
+	b = AppendFormat(b, n.Time, FormatOptions{})
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decodes a JSON string into a valid time, or null into a time that is not valid.
+func (n *NullTime) UnmarshalJSON(b []byte) error {
+	if null(b) {
+		*n = NullTime{}
+		return nil
+	}
+	var t Time
+	if err := t.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	*n = NewNullTime(t.Time)
+	return nil
+}
+
+// Value implements driver.Valuer by returning the time, or nil if it is not valid.
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time, nil
+}
+
+// Scan implements sql.Scanner by decoding a time.Time, an ISO8601 time from a string or byte slice, or nil.
+func (n *NullTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*n = NullTime{}
+	case time.Time:
+		*n = NewNullTime(v)
+	case string:
+		t, err := ParseString(v)
+		if err != nil {
+			return err
+		}
+		*n = NewNullTime(t)
+	case []byte:
+		return n.UnmarshalText(v)
+	default:
+		return fmt.Errorf("iso8601: Cannot scan %T into a time", src)
+	}
+	return nil
+}
+
+// OptionalTime is a NullTime that also records whether it was given in JSON,
+// to tell an absent field, an explicit null and a time apart, such as in the body of a PATCH request.
+//
+// A field that is absent from the JSON input is left with Set false.
+// OptionalTime is encoded as null unless it is valid, and a field tagged `omitzero` is omitted when it is not set.
+type OptionalTime struct {
+	NullTime
+	// Set is true if the time was given, either as null or as a time.
+	Set bool
+}
+
+// IsZero reports whether the time was not given, so that a field tagged `omitzero` is omitted.
+func (o OptionalTime) IsZero() bool {
+	return !o.Set
+}
+
+// MarshalJSON encodes the time as a JSON string, or as null if it is not valid.
+func (o OptionalTime) MarshalJSON() ([]byte, error) {
+	return o.NullTime.MarshalJSON()
+}
+
+// UnmarshalJSON decodes a JSON string or null, and records that the time was given.
+func (o *OptionalTime) UnmarshalJSON(b []byte) error {
+	if err := o.NullTime.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	o.Set = true
+	return nil
+}
+
+// UnmarshalText decodes an ISO8601 time, or a null time from empty text, and records that the time was given.
+func (o *OptionalTime) UnmarshalText(b []byte) error {
+	if err := o.NullTime.UnmarshalText(b); err != nil {
+		return err
+	}
+	o.Set = true
+	return nil
+}
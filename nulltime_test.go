@@ -0,0 +1,112 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNullTime_JSON(t *testing.T) {
+	var v struct {
+		A, B NullTime
+	}
+	v.B = NewNullTime(time.Now())
+	if err := json.Unmarshal([]byte(`{"A": "2020-06-01T09:00:00+01:00", "B": null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if !v.A.Valid || !v.A.Time.Equal(time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("A = %+v", v.A)
+	}
+	if v.B.Valid || !v.B.Time.IsZero() {
+		t.Errorf("B = %+v; want null", v.B)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"A":"2020-06-01T09:00:00+01:00","B":null}` {
+		t.Errorf("json.Marshal = %s", s)
+	}
+	if err := json.Unmarshal([]byte(`{"A": 1}`), &v); !errors.Is(err, ErrNotString) {
+		t.Errorf("expected to return error %v, got %v", ErrNotString, err)
+	}
+}
+
+func TestNullTime_Text(t *testing.T) {
+	var n NullTime
+	if err := n.UnmarshalText([]byte("2020-06-01T09:00:00Z")); err != nil || !n.Valid {
+		t.Fatalf("UnmarshalText = %+v, %v", n, err)
+	}
+	if b, err := n.MarshalText(); err != nil || string(b) != "2020-06-01T09:00:00Z" {
+		t.Errorf("MarshalText = %s, %v", b, err)
+	}
+	if err := n.UnmarshalText(nil); err != nil || n.Valid {
+		t.Errorf("UnmarshalText(empty) = %+v, %v", n, err)
+	}
+	if b, err := n.MarshalText(); err != nil || len(b) != 0 {
+		t.Errorf("MarshalText = %q, %v; want empty", b, err)
+	}
+}
+
+func TestNullTime_SQL(t *testing.T) {
+	d := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+	if v, err := NewNullTime(d).Value(); err != nil || v != d {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+	if v, err := (NullTime{}).Value(); err != nil || v != nil {
+		t.Errorf("Value = %v, %v; want nil", v, err)
+	}
+
+	var n NullTime
+	if err := n.Scan(d); err != nil || !n.Valid || !n.Time.Equal(d) {
+		t.Errorf("Scan(time.Time) = %+v, %v", n, err)
+	}
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v", n, err)
+	}
+	if err := n.Scan("2020-06-01T09:00:00Z"); err != nil || !n.Valid || !n.Time.Equal(d) {
+		t.Errorf("Scan(string) = %+v, %v", n, err)
+	}
+	if err := n.Scan([]byte("2020-06-01T10:00:00+01:00")); err != nil || !n.Valid || !n.Time.Equal(d) {
+		t.Errorf("Scan([]byte) = %+v, %v", n, err)
+	}
+	if err := n.Scan(int64(1)); err == nil {
+		t.Error("Expected an error scanning an integer")
+	}
+}
+
+func TestOptionalTime(t *testing.T) {
+	var v struct {
+		Absent, Null, Value OptionalTime
+	}
+	if err := json.Unmarshal([]byte(`{"Null": null, "Value": "2020-06-01T09:00:00Z"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Absent.Set || v.Absent.Valid {
+		t.Errorf("Absent = %+v; want not set", v.Absent)
+	}
+	if !v.Null.Set || v.Null.Valid {
+		t.Errorf("Null = %+v; want set to null", v.Null)
+	}
+	if !v.Value.Set || !v.Value.Valid || !v.Value.Time.Equal(time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Value = %+v; want set to a time", v.Value)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Absent":null,"Null":null,"Value":"2020-06-01T09:00:00Z"}` {
+		t.Errorf("json.Marshal = %s", s)
+	}
+	if !v.Absent.IsZero() || v.Null.IsZero() {
+		t.Error("expected only an absent time to be zero")
+	}
+
+	var o OptionalTime
+	if err := o.UnmarshalText([]byte("2020-06-01")); err != nil || !o.Set || !o.Valid {
+		t.Errorf("UnmarshalText = %+v, %v", o, err)
+	}
+}
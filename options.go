@@ -0,0 +1,140 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// ParseOptions configures the behaviour of the parser.
+// The zero value parses input in the same way as the package level functions.
+type ParseOptions struct {
+	// RejectUnknownOffset rejects the RFC 3339 unknown local offset (`-00:00`) with ErrInvalidZone
+	// instead of returning a time in the UnknownOffset location.
+	RejectUnknownOffset bool
+
+	// MaxZoneOffset is the largest magnitude of a zone offset, such as 14 hours.
+	// Offsets beyond it are reported as a *RangeError on the "zone hour", "zone minute" or "zone second" element.
+	// The zero value accepts offsets up to ±23:59 (or ±23:59:59 with AllowZoneSeconds).
+	MaxZoneOffset time.Duration
+
+	// AllowZoneSeconds accepts zone offsets with a seconds component (`±hh:mm:ss` or `±hhmmss`).
+	// RFC 9557 and Java emit these for the local mean time offsets of historic dates, such as `+00:09:21`.
+	AllowZoneSeconds bool
+
+	// ZeroOffsetUTC returns times with a positive zero offset (`+00:00`) in time.UTC
+	// instead of an unnamed location with a zero offset.
+	ZeroOffsetUTC bool
+
+	// AllowSignedDuration accepts durations with a leading sign (`-P1D` or `+P1D`) as defined by ISO 8601-2.
+	// A negative duration is returned with Duration.Negative set.
+	AllowSignedDuration bool
+
+	// AllowCombinedWeeks accepts durations that combine weeks with other components (`P1W2D`) as defined by ISO 8601-2.
+	AllowCombinedWeeks bool
+
+	// RejectDurationWeeks rejects durations with a weeks component (`P1W`).
+	RejectDurationWeeks bool
+
+	// RejectDurationFraction rejects durations with a fraction of a second (`PT0.5S`).
+	RejectDurationFraction bool
+
+	// RejectAlternativeDuration rejects durations in the alternative format (`P0001-02-03T04:05:06`).
+	RejectAlternativeDuration bool
+
+	// Date selects the representation of the date in the input.
+	// DateCalendar accepts calendar and ordinal dates, as the package level functions do.
+	// DateOrdinal only accepts ordinal dates and DateWeek only accepts week dates.
+	// DateMonthDay accepts a month and day without a year (`--01-02` or `01-02`), which is returned in the year 0.
+	// DateOmit accepts a time of day without a date, which is returned on the 1st of January of the year 0, like time.Parse.
+	Date DateForm
+
+	// Precisions restricts the precision of the input, given by its smallest component, to the set.
+	// Input with any other precision is rejected with ErrUnsupportedPrecision.
+	// A fraction of a second has millisecond precision with up to 3 digits, microsecond precision with up to 6, and nanosecond precision otherwise.
+	// The zero value accepts any precision.
+	Precisions PrecisionSet
+
+	// Strict only accepts components with their full number of digits:
+	// at least four for the year and exactly two for the month, day, hour, minute and second.
+	// It also rejects ordinal dates unless Date is DateOrdinal, a leading `+` on the year, a separator not followed by a time,
+	// and zone offsets that are not in the extended format with a minute (`+01:00`).
+	Strict bool
+
+	// RequireZone rejects input with a time of day but no zone information with ErrMissingZone.
+	RequireZone bool
+
+	// RejectZone rejects input with zone information.
+	RejectZone bool
+
+	// AllowDateZone accepts zone information after a date without a time of day, as used by XML Schema (`2006-01-02Z`).
+	AllowDateZone bool
+
+	// AllowNegativeYear accepts years with a leading minus sign (`-0044-03-15`).
+	AllowNegativeYear bool
+
+	// RejectSpaceSeparator rejects a space instead of `T` between the date and time.
+	RejectSpaceSeparator bool
+
+	// AllowLowercase accepts the lowercase designators `t` and `z` permitted by RFC 3339.
+	AllowLowercase bool
+
+	// DST selects how input without zone information is resolved when its wall clock time
+	// is skipped or repeated in the location by a daylight saving time transition.
+	// The zero value resolves it in the same way as time.Date.
+	DST DSTPolicy
+
+	// ConvertToLocation returns input with zone information in the location given to ParseInLocation,
+	// or UTC for Parse, instead of a location with the offset of the input.
+	ConvertToLocation bool
+
+	// MatchLocationOffset rejects input with zone information whose offset differs from that of the location
+	// given to ParseInLocation at the same instant with ErrOffsetMismatch.
+	// Input with a matching offset is returned in the location.
+	// The RFC 3339 unknown local offset (`-00:00`) matches any location.
+	MatchLocationOffset bool
+}
+
+// ParseISOZone parses the zone information in an ISO8601 date string using these options.
+// See the package level ParseISOZone for the accepted input.
+func (o ParseOptions) ParseISOZone(inp []byte) (*time.Location, error) {
+	return parseISOZone(inp, &o)
+}
+
+// ParseISOOffset parses the zone information in an ISO8601 date string using these options.
+// See the package level ParseISOOffset for the returned values.
+func (o ParseOptions) ParseISOOffset(inp []byte) (seconds int, kind ZoneKind, err error) {
+	return parseISOOffset(inp, &o)
+}
+
+// Parse parses an ISO8601 compliant date-time byte slice into a time.Time object using these options.
+func (o ParseOptions) Parse(inp []byte) (time.Time, error) {
+	return parseInLocation(inp, time.UTC, &o)
+}
+
+// ParseInLocation parses an ISO8601 compliant date-time byte slice into a time.Time object using these options.
+// If the input does not have timezone information, it will use the given location.
+func (o ParseOptions) ParseInLocation(inp []byte, loc *time.Location) (time.Time, error) {
+	return parseInLocation(inp, loc, &o)
+}
+
+// ParseString parses an ISO8601 compliant date-time string into a time.Time object using these options.
+func (o ParseOptions) ParseString(inp string) (time.Time, error) {
+	return parseInLocation(inp, time.UTC, &o)
+}
+
+// ParseStringInLocation parses an ISO8601 compliant date-time string into a time.Time object using these options.
+// If the input does not have timezone information, it will use the given location.
+func (o ParseOptions) ParseStringInLocation(inp string, loc *time.Location) (time.Time, error) {
+	return parseInLocation(inp, loc, &o)
+}
+
+// ParseDuration parses an ISO8601 duration using these options.
+// See the package level ParseDuration for the accepted input.
+func (o ParseOptions) ParseDuration(inp []byte) (Duration, error) {
+	return parseDuration(inp, &o)
+}
+
+// ParseDurationString parses an ISO8601 duration string using these options.
+// See the package level ParseDuration for the accepted input.
+func (o ParseOptions) ParseDurationString(inp string) (Duration, error) {
+	return parseDuration(inp, &o)
+}
@@ -0,0 +1,333 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// Profile is the subset of ISO8601 used by another specification, such as XML Schema or TOML.
+// It pairs the options that parse input in the profile with the options that format a time in it.
+type Profile struct {
+	// Name identifies the profile, such as `xs:dateTime`.
+	Name string
+
+	ParseOptions  ParseOptions
+	FormatOptions FormatOptions
+
+	// Location is the location of a time parsed by Parse or ParseString without zone information.
+	// If nil then time.UTC is used.
+	Location *time.Location
+}
+
+// fractionalPrecisions are the precisions of a time with seconds and an optional fraction of a second.
+var fractionalPrecisions = Precisions(PrecisionSecond, PrecisionMillisecond, PrecisionMicrosecond, PrecisionNanosecond)
+
+var (
+	// W3CDTF is the W3C Date and Time Formats note (NOTE-datetime).
+	// It accepts its six levels of precision: a year, a year and month, a complete date,
+	// and a complete date with hours and minutes, with seconds, or with a fraction of a second.
+	// A time of day must have a zone. Use WithPrecision to format a time at a given level.
+	W3CDTF = Profile{
+		Name: "W3C-DTF",
+		ParseOptions: ParseOptions{
+			Strict:               true,
+			RequireZone:          true,
+			RejectSpaceSeparator: true,
+			Precisions: Precisions(PrecisionYear, PrecisionMonth, PrecisionDay, PrecisionMinute,
+				PrecisionSecond, PrecisionMillisecond, PrecisionMicrosecond, PrecisionNanosecond),
+		},
+	}
+
+	// XSDDateTime is the XML Schema `xs:dateTime` type, with an optional zone and negative years.
+	// As with all of the XML Schema profiles, a time without a zone is parsed in NoZone and formatted without a zone,
+	// while a time in UTC is formatted with `Z`.
+	XSDDateTime = Profile{
+		Name: "xs:dateTime",
+		ParseOptions: ParseOptions{
+			Strict:               true,
+			AllowNegativeYear:    true,
+			RejectSpaceSeparator: true,
+			Precisions:           fractionalPrecisions,
+		},
+		Location: NoZone,
+	}
+
+	// XSDDate is the XML Schema `xs:date` type, which may have a zone (`2006-01-02Z`).
+	XSDDate = Profile{
+		Name: "xs:date",
+		ParseOptions: ParseOptions{
+			Strict:            true,
+			AllowNegativeYear: true,
+			AllowDateZone:     true,
+			Precisions:        Precisions(PrecisionDay),
+		},
+		FormatOptions: FormatOptions{Precision: PrecisionDay, DateZone: true},
+		Location:      NoZone,
+	}
+
+	// XSDTime is the XML Schema `xs:time` type, with an optional zone.
+	XSDTime = Profile{
+		Name: "xs:time",
+		ParseOptions: ParseOptions{
+			Date:       DateOmit,
+			Strict:     true,
+			Precisions: fractionalPrecisions,
+		},
+		FormatOptions: FormatOptions{Date: DateOmit},
+		Location:      NoZone,
+	}
+
+	// XSDGYearMonth is the XML Schema `xs:gYearMonth` type, which may have a zone (`2006-01Z`).
+	XSDGYearMonth = Profile{
+		Name: "xs:gYearMonth",
+		ParseOptions: ParseOptions{
+			Strict:            true,
+			AllowNegativeYear: true,
+			AllowDateZone:     true,
+			Precisions:        Precisions(PrecisionMonth),
+		},
+		FormatOptions: FormatOptions{Precision: PrecisionMonth, DateZone: true},
+		Location:      NoZone,
+	}
+
+	// TOMLOffsetDateTime is the TOML offset date-time, which may separate the date and time with a space.
+	TOMLOffsetDateTime = Profile{
+		Name: "TOML offset date-time",
+		ParseOptions: ParseOptions{
+			Strict:         true,
+			RequireZone:    true,
+			AllowLowercase: true,
+			Precisions:     fractionalPrecisions,
+		},
+	}
+
+	// TOMLLocalDateTime is the TOML local date-time, without a zone.
+	TOMLLocalDateTime = Profile{
+		Name: "TOML local date-time",
+		ParseOptions: ParseOptions{
+			Strict:         true,
+			RejectZone:     true,
+			AllowLowercase: true,
+			Precisions:     fractionalPrecisions,
+		},
+		FormatOptions: FormatOptions{Zone: ZoneFormatOmit},
+	}
+
+	// TOMLLocalDate is the TOML local date.
+	TOMLLocalDate = Profile{
+		Name: "TOML local date",
+		ParseOptions: ParseOptions{
+			Strict:     true,
+			RejectZone: true,
+			Precisions: Precisions(PrecisionDay),
+		},
+		FormatOptions: FormatOptions{Precision: PrecisionDay},
+	}
+
+	// TOMLLocalTime is the TOML local time, without a zone.
+	TOMLLocalTime = Profile{
+		Name: "TOML local time",
+		ParseOptions: ParseOptions{
+			Date:       DateOmit,
+			Strict:     true,
+			RejectZone: true,
+			Precisions: fractionalPrecisions,
+		},
+		FormatOptions: FormatOptions{Date: DateOmit, Zone: ZoneFormatOmit},
+	}
+
+	// HTMLMonth is the HTML month string (`2006-01`).
+	HTMLMonth = Profile{
+		Name: "HTML month",
+		ParseOptions: ParseOptions{
+			Strict:     true,
+			RejectZone: true,
+			Precisions: Precisions(PrecisionMonth),
+		},
+		FormatOptions: FormatOptions{Precision: PrecisionMonth},
+	}
+
+	// HTMLWeek is the HTML week string (`2006-W01`), which is parsed as the Monday of the week.
+	HTMLWeek = Profile{
+		Name: "HTML week",
+		ParseOptions: ParseOptions{
+			Date:       DateWeek,
+			Strict:     true,
+			RejectZone: true,
+			Precisions: Precisions(PrecisionMonth),
+		},
+		FormatOptions: FormatOptions{Date: DateWeek, Precision: PrecisionMonth},
+	}
+
+	// HTMLYearlessDate is the HTML yearless date string (`--01-02` or `01-02`), which is parsed in the year 0.
+	HTMLYearlessDate = Profile{
+		Name: "HTML yearless date",
+		ParseOptions: ParseOptions{
+			Date:       DateMonthDay,
+			Strict:     true,
+			RejectZone: true,
+			Precisions: Precisions(PrecisionDay),
+		},
+		FormatOptions: FormatOptions{Date: DateMonthDay, Precision: PrecisionDay},
+	}
+
+	// HTMLLocalDateTime is the HTML local date and time string, with minutes, seconds or milliseconds and no zone.
+	// The date and time may be separated by a space.
+	HTMLLocalDateTime = Profile{
+		Name: "HTML local date and time",
+		ParseOptions: ParseOptions{
+			Strict:     true,
+			RejectZone: true,
+			Precisions: Precisions(PrecisionMinute, PrecisionSecond, PrecisionMillisecond),
+		},
+		FormatOptions: FormatOptions{Precision: PrecisionMillisecond, Zone: ZoneFormatOmit},
+	}
+
+	// JSONSchemaDateTime is the JSON Schema `date-time` format, an RFC 3339 date-time.
+	JSONSchemaDateTime = Profile{
+		Name: "date-time",
+		ParseOptions: ParseOptions{
+			Strict:               true,
+			RequireZone:          true,
+			RejectSpaceSeparator: true,
+			AllowLowercase:       true,
+			Precisions:           fractionalPrecisions,
+		},
+	}
+
+	// JSONSchemaDate is the JSON Schema `date` format, an RFC 3339 full-date.
+	JSONSchemaDate = Profile{
+		Name: "date",
+		ParseOptions: ParseOptions{
+			Strict:     true,
+			RejectZone: true,
+			Precisions: Precisions(PrecisionDay),
+		},
+		FormatOptions: FormatOptions{Precision: PrecisionDay},
+	}
+
+	// JSONSchemaTime is the JSON Schema `time` format, an RFC 3339 full-time with a zone.
+	JSONSchemaTime = Profile{
+		Name: "time",
+		ParseOptions: ParseOptions{
+			Date:           DateOmit,
+			Strict:         true,
+			RequireZone:    true,
+			AllowLowercase: true,
+			Precisions:     fractionalPrecisions,
+		},
+		FormatOptions: FormatOptions{Date: DateOmit},
+	}
+)
+
+// Parse parses a date-time byte slice in the profile into a time.Time object.
+// If the input does not have timezone information, it will use the location of the profile.
+func (p Profile) Parse(inp []byte) (time.Time, error) {
+	return parseInLocation(inp, p.location(), &p.ParseOptions)
+}
+
+// ParseInLocation parses a date-time byte slice in the profile into a time.Time object.
+// If the input does not have timezone information, it will use the given location.
+func (p Profile) ParseInLocation(inp []byte, loc *time.Location) (time.Time, error) {
+	return parseInLocation(inp, loc, &p.ParseOptions)
+}
+
+// ParseString parses a date-time string in the profile into a time.Time object.
+// If the input does not have timezone information, it will use the location of the profile.
+func (p Profile) ParseString(inp string) (time.Time, error) {
+	return parseInLocation(inp, p.location(), &p.ParseOptions)
+}
+
+// location returns the location of a time parsed without zone information.
+func (p Profile) location() *time.Location {
+	if p.Location == nil {
+		return time.UTC
+	}
+	return p.Location
+}
+
+// ParseStringInLocation parses a date-time string in the profile into a time.Time object.
+// If the input does not have timezone information, it will use the given location.
+func (p Profile) ParseStringInLocation(inp string, loc *time.Location) (time.Time, error) {
+	return parseInLocation(inp, loc, &p.ParseOptions)
+}
+
+// Format returns the representation of t in the profile.
+func (p Profile) Format(t time.Time) string {
+	return Format(t, p.FormatOptions)
+}
+
+// AppendFormat is like Format but appends the representation of t to dst and returns the extended buffer.
+func (p Profile) AppendFormat(dst []byte, t time.Time) []byte {
+	return AppendFormat(dst, t, p.FormatOptions)
+}
+
+// WithPrecision returns a copy of the profile that formats times with the given precision,
+// such as one of the levels of W3CDTF.
+func (p Profile) WithPrecision(precision Precision) Profile {
+	p.FormatOptions.Precision = precision
+	return p
+}
+
+// DurationProfile is the subset of ISO8601 durations used by another specification.
+type DurationProfile struct {
+	// Name identifies the profile, such as `xs:duration`.
+	Name string
+
+	ParseOptions ParseOptions
+}
+
+var (
+	// XSDDuration is the XML Schema `xs:duration` type, which may be negative but has no weeks.
+	XSDDuration = DurationProfile{
+		Name: "xs:duration",
+		ParseOptions: ParseOptions{
+			AllowSignedDuration:       true,
+			RejectDurationWeeks:       true,
+			RejectAlternativeDuration: true,
+		},
+	}
+
+	// JSONSchemaDuration is the JSON Schema `duration` format, an RFC 3339 duration without a fraction of a second.
+	JSONSchemaDuration = DurationProfile{
+		Name: "duration",
+		ParseOptions: ParseOptions{
+			RejectDurationFraction:    true,
+			RejectAlternativeDuration: true,
+		},
+	}
+)
+
+// Parse parses a duration in the profile.
+func (p DurationProfile) Parse(inp []byte) (Duration, error) {
+	return parseDuration(inp, &p.ParseOptions)
+}
+
+// ParseString parses a duration string in the profile.
+func (p DurationProfile) ParseString(inp string) (Duration, error) {
+	return parseDuration(inp, &p.ParseOptions)
+}
+
+// Format returns the representation of d in the profile.
+// Weeks are written as days if the profile does not accept them.
+// A negative duration or a fraction of a second that the profile does not accept
+// returns ErrNegativeDuration or ErrUnsupportedPrecision.
+func (p DurationProfile) Format(d Duration) (string, error) {
+	b, err := p.AppendFormat(make([]byte, 0, 32), d)
+	return string(b), err
+}
+
+// AppendFormat is like Format but appends the representation of d to dst and returns the extended buffer.
+func (p DurationProfile) AppendFormat(dst []byte, d Duration) ([]byte, error) {
+	o := &p.ParseOptions
+	switch {
+	case d.Negative && !d.IsZero() && !o.AllowSignedDuration:
+		return dst, ErrNegativeDuration
+	case d.Nanoseconds != 0 && o.RejectDurationFraction:
+		return dst, ErrUnsupportedPrecision
+	}
+	if d.Weeks != 0 && (o.RejectDurationWeeks || (!o.AllowCombinedWeeks && d != (Duration{Negative: d.Negative, Weeks: d.Weeks}))) {
+		d.Days += 7 * d.Weeks
+		d.Weeks = 0
+	}
+	return d.AppendFormat(dst), nil
+}
@@ -0,0 +1,281 @@
+package iso8601
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProfileParse(t *testing.T) {
+	var cases = []struct {
+		Profile Profile
+		Using   string
+		Expect  time.Time
+		Err     error
+	}{
+		{Profile: W3CDTF, Using: "1997", Expect: time.Date(1997, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Profile: W3CDTF, Using: "1997-07", Expect: time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{Profile: W3CDTF, Using: "1997-07-16", Expect: time.Date(1997, 7, 16, 0, 0, 0, 0, time.UTC)},
+		{Profile: W3CDTF, Using: "1997-07-16T19:20+01:00", Expect: time.Date(1997, 7, 16, 19, 20, 0, 0, time.FixedZone("", 3600))},
+		{Profile: W3CDTF, Using: "1997-07-16T19:20:30+01:00", Expect: time.Date(1997, 7, 16, 19, 20, 30, 0, time.FixedZone("", 3600))},
+		{Profile: W3CDTF, Using: "1997-07-16T19:20:30.45Z", Expect: time.Date(1997, 7, 16, 19, 20, 30, 450000000, time.UTC)},
+		{Profile: W3CDTF, Using: "1997-07-16T19Z", Err: ErrUnsupportedPrecision},
+		{Profile: W3CDTF, Using: "1997-07-16T19:20", Err: ErrMissingZone},
+		{Profile: W3CDTF, Using: "1997-07-16 19:20Z", Err: UnexpectedCharacterError{Character: ' '}},
+		{Profile: W3CDTF, Using: "1997-7-16", Err: UnexpectedCharacterError{Character: '-'}},
+		{Profile: W3CDTF, Using: "1997-07-16T", Err: ErrUnexpectedEnd},
+		{Profile: W3CDTF, Using: "1997-07-16T19:20+0100", Err: ErrZoneCharacters},
+		{Profile: W3CDTF, Using: "+1997-07-16", Err: UnexpectedCharacterError{Character: '+'}},
+
+		{Profile: XSDDateTime, Using: "2002-10-10T12:00:00-05:00", Expect: time.Date(2002, 10, 10, 12, 0, 0, 0, time.FixedZone("", -5*3600))},
+		{Profile: XSDDateTime, Using: "2002-10-10T12:00:00", Expect: time.Date(2002, 10, 10, 12, 0, 0, 0, time.UTC)},
+		{Profile: XSDDateTime, Using: "-0044-03-15T12:00:00Z", Expect: time.Date(-44, 3, 15, 12, 0, 0, 0, time.UTC)},
+		{Profile: XSDDateTime, Using: "2002-10-10T12:00Z", Err: ErrUnsupportedPrecision},
+		{Profile: XSDDateTime, Using: "2002-10-10", Err: ErrUnsupportedPrecision},
+		{Profile: XSDDate, Using: "2002-10-10", Expect: time.Date(2002, 10, 10, 0, 0, 0, 0, time.UTC)},
+		{Profile: XSDDate, Using: "2002-10-10Z", Expect: time.Date(2002, 10, 10, 0, 0, 0, 0, time.UTC)},
+		{Profile: XSDDate, Using: "2002-10-10+13:00", Expect: time.Date(2002, 10, 10, 0, 0, 0, 0, time.FixedZone("", 13*3600))},
+		{Profile: XSDDate, Using: "2002-10-10-05:00", Expect: time.Date(2002, 10, 10, 0, 0, 0, 0, time.FixedZone("", -5*3600))},
+		{Profile: XSDDate, Using: "-0001-12-31", Expect: time.Date(-1, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{Profile: XSDDate, Using: "2002-10", Err: ErrUnsupportedPrecision},
+		{Profile: XSDTime, Using: "13:20:00", Expect: time.Date(0, 1, 1, 13, 20, 0, 0, time.UTC)},
+		{Profile: XSDTime, Using: "13:20:30.5555-05:00", Expect: time.Date(0, 1, 1, 13, 20, 30, 555500000, time.FixedZone("", -5*3600))},
+		{Profile: XSDTime, Using: "T13:20:00", Err: UnexpectedCharacterError{Character: 'T'}},
+		{Profile: XSDTime, Using: "13:20", Err: ErrUnsupportedPrecision},
+		{Profile: XSDTime, Using: "", Err: ErrUnexpectedEnd},
+		{Profile: XSDGYearMonth, Using: "2001-10", Expect: time.Date(2001, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{Profile: XSDGYearMonth, Using: "2001-10Z", Expect: time.Date(2001, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{Profile: XSDGYearMonth, Using: "2001-10-05:00", Expect: time.Date(2001, 10, 1, 0, 0, 0, 0, time.FixedZone("", -5*3600))},
+
+		{Profile: TOMLOffsetDateTime, Using: "1979-05-27T07:32:00Z", Expect: time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC)},
+		{Profile: TOMLOffsetDateTime, Using: "1979-05-27 07:32:00.999999-07:00", Expect: time.Date(1979, 5, 27, 7, 32, 0, 999999000, time.FixedZone("", -7*3600))},
+		{Profile: TOMLOffsetDateTime, Using: "1979-05-27t07:32:00z", Expect: time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC)},
+		{Profile: TOMLOffsetDateTime, Using: "1979-05-27T07:32:00", Err: ErrMissingZone},
+		{Profile: TOMLLocalDateTime, Using: "1979-05-27T07:32:00", Expect: time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC)},
+		{Profile: TOMLLocalDateTime, Using: "1979-05-27T07:32:00Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Profile: TOMLLocalDate, Using: "1979-05-27", Expect: time.Date(1979, 5, 27, 0, 0, 0, 0, time.UTC)},
+		{Profile: TOMLLocalTime, Using: "00:32:00.999999", Expect: time.Date(0, 1, 1, 0, 32, 0, 999999000, time.UTC)},
+		{Profile: TOMLLocalTime, Using: "07:32:00+01:00", Err: UnexpectedCharacterError{Character: '+'}},
+
+		{Profile: HTMLMonth, Using: "2011-11", Expect: time.Date(2011, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{Profile: HTMLMonth, Using: "2011-11-18", Err: ErrUnsupportedPrecision},
+		{Profile: HTMLWeek, Using: "2020-W53", Expect: time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC)},
+		{Profile: HTMLWeek, Using: "2009-W01", Expect: time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{Profile: HTMLWeek, Using: "2020-W53-5", Err: ErrUnsupportedPrecision},
+		{Profile: HTMLWeek, Using: "2021-W53", Err: &RangeError{Element: "week", Given: 53, Min: 1, Max: 52}},
+		{Profile: HTMLWeek, Using: "2020-12", Err: UnexpectedCharacterError{Character: '1'}},
+		{Profile: HTMLYearlessDate, Using: "--02-29", Expect: time.Date(0, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{Profile: HTMLYearlessDate, Using: "11-18", Expect: time.Date(0, 11, 18, 0, 0, 0, 0, time.UTC)},
+		{Profile: HTMLYearlessDate, Using: "--02-30", Err: &RangeError{Element: "day", Given: 30, Min: 1, Max: 29}},
+		{Profile: HTMLYearlessDate, Using: "1118", Err: UnexpectedCharacterError{Character: '1'}},
+		{Profile: HTMLLocalDateTime, Using: "2011-11-18T14:54", Expect: time.Date(2011, 11, 18, 14, 54, 0, 0, time.UTC)},
+		{Profile: HTMLLocalDateTime, Using: "2011-11-18 14:54:39.929", Expect: time.Date(2011, 11, 18, 14, 54, 39, 929000000, time.UTC)},
+		{Profile: HTMLLocalDateTime, Using: "2011-11-18T14:54:39.9291", Err: ErrUnsupportedPrecision},
+		{Profile: HTMLLocalDateTime, Using: "2011-11-18T14:54Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+
+		{Profile: JSONSchemaDateTime, Using: "1985-04-12T23:20:50.52Z", Expect: time.Date(1985, 4, 12, 23, 20, 50, 520000000, time.UTC)},
+		{Profile: JSONSchemaDateTime, Using: "1996-12-19t16:39:57-08:00", Expect: time.Date(1996, 12, 19, 16, 39, 57, 0, time.FixedZone("", -8*3600))},
+		{Profile: JSONSchemaDateTime, Using: "1996-12-19 16:39:57Z", Err: UnexpectedCharacterError{Character: ' '}},
+		{Profile: JSONSchemaDateTime, Using: "1996-12-19T16:39Z", Err: ErrUnsupportedPrecision},
+		{Profile: JSONSchemaDateTime, Using: "1996-354T16:39:57Z", Err: UnexpectedCharacterError{Character: 'T'}},
+		{Profile: JSONSchemaDate, Using: "1985-04-12", Expect: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Profile: JSONSchemaDate, Using: "1985-04-12Z", Err: UnexpectedCharacterError{Character: 'Z'}},
+		{Profile: JSONSchemaTime, Using: "23:20:50.52Z", Expect: time.Date(0, 1, 1, 23, 20, 50, 520000000, time.UTC)},
+		{Profile: JSONSchemaTime, Using: "23:20:50", Err: ErrMissingZone},
+		{Profile: JSONSchemaTime, Using: "-05:00", Err: UnexpectedCharacterError{Character: '-'}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Profile.Name+" "+c.Using, func(t *testing.T) {
+			d, err := c.Profile.ParseString(c.Using)
+			if c.Err != nil {
+				var rangeErr *RangeError
+				if want, ok := c.Err.(*RangeError); ok {
+					if !errors.As(err, &rangeErr) || rangeErr.Element != want.Element || rangeErr.Given != want.Given || rangeErr.Max != want.Max {
+						t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+					}
+					return
+				}
+				if !errors.Is(err, c.Err) {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !d.Equal(c.Expect) {
+				t.Errorf("ParseString = %s; want %s", d, c.Expect)
+			}
+			if _, offset := d.Zone(); true {
+				if _, want := c.Expect.Zone(); offset != want {
+					t.Errorf("offset = %d; want %d", offset, want)
+				}
+			}
+
+			b, err := c.Profile.Parse([]byte(c.Using))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !b.Equal(d) {
+				t.Errorf("Parse = %s; want %s", b, d)
+			}
+		})
+	}
+}
+
+func TestProfileFormat(t *testing.T) {
+	var tm = time.Date(2011, 11, 18, 14, 54, 39, 929000000, time.FixedZone("", -8*3600))
+
+	var cases = []struct {
+		Profile Profile
+		Expect  string
+	}{
+		{Profile: W3CDTF, Expect: "2011-11-18T14:54:39.929-08:00"},
+		{Profile: W3CDTF.WithPrecision(PrecisionYear), Expect: "2011"},
+		{Profile: W3CDTF.WithPrecision(PrecisionMonth), Expect: "2011-11"},
+		{Profile: W3CDTF.WithPrecision(PrecisionDay), Expect: "2011-11-18"},
+		{Profile: W3CDTF.WithPrecision(PrecisionMinute), Expect: "2011-11-18T14:54-08:00"},
+		{Profile: W3CDTF.WithPrecision(PrecisionSecond), Expect: "2011-11-18T14:54:39-08:00"},
+		{Profile: XSDDateTime, Expect: "2011-11-18T14:54:39.929-08:00"},
+		{Profile: XSDDate, Expect: "2011-11-18-08:00"},
+		{Profile: XSDTime, Expect: "14:54:39.929-08:00"},
+		{Profile: XSDGYearMonth, Expect: "2011-11-08:00"},
+		{Profile: TOMLOffsetDateTime, Expect: "2011-11-18T14:54:39.929-08:00"},
+		{Profile: TOMLLocalDateTime, Expect: "2011-11-18T14:54:39.929"},
+		{Profile: TOMLLocalDate, Expect: "2011-11-18"},
+		{Profile: TOMLLocalTime, Expect: "14:54:39.929"},
+		{Profile: HTMLMonth, Expect: "2011-11"},
+		{Profile: HTMLWeek, Expect: "2011-W46"},
+		{Profile: HTMLYearlessDate, Expect: "--11-18"},
+		{Profile: HTMLLocalDateTime, Expect: "2011-11-18T14:54:39.929"},
+		{Profile: JSONSchemaDateTime, Expect: "2011-11-18T14:54:39.929-08:00"},
+		{Profile: JSONSchemaDate, Expect: "2011-11-18"},
+		{Profile: JSONSchemaTime, Expect: "14:54:39.929-08:00"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Profile.Name, func(t *testing.T) {
+			s := c.Profile.Format(tm)
+			if s != c.Expect {
+				t.Fatalf("Format = %q; want %q", s, c.Expect)
+			}
+			if c.Profile.FormatOptions.Precision == PrecisionYear {
+				return
+			}
+			// Every formatted time is accepted by the profile.
+			if _, err := c.Profile.ParseString(s); err != nil {
+				t.Errorf("ParseString(%q) = %v", s, err)
+			}
+		})
+	}
+}
+
+func TestProfileRoundTrip(t *testing.T) {
+	var cases = []struct {
+		Profile Profile
+		Using   string
+	}{
+		{Profile: XSDDateTime, Using: "2002-10-10T12:00:00"},
+		{Profile: XSDDateTime, Using: "2002-10-10T12:00:00Z"},
+		{Profile: XSDDateTime, Using: "2002-10-10T12:00:00.5-05:00"},
+		{Profile: XSDDate, Using: "2002-10-10"},
+		{Profile: XSDDate, Using: "2002-10-10Z"},
+		{Profile: XSDDate, Using: "2002-10-10-05:00"},
+		{Profile: XSDDate, Using: "2002-10-10+13:00"},
+		{Profile: XSDDate, Using: "-0001-12-31"},
+		{Profile: XSDTime, Using: "13:20:00"},
+		{Profile: XSDTime, Using: "13:20:00Z"},
+		{Profile: XSDTime, Using: "13:20:30.5555-05:00"},
+		{Profile: XSDGYearMonth, Using: "2001-10"},
+		{Profile: XSDGYearMonth, Using: "2001-10Z"},
+		{Profile: XSDGYearMonth, Using: "2001-10-05:00"},
+		{Profile: XSDGYearMonth, Using: "2001-10+05:30"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Profile.Name+" "+c.Using, func(t *testing.T) {
+			d, err := c.Profile.ParseString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := c.Profile.Format(d); s != c.Using {
+				t.Errorf("Format = %q; want %q", s, c.Using)
+			}
+		})
+	}
+}
+
+func TestDurationProfile(t *testing.T) {
+	var cases = []struct {
+		Profile DurationProfile
+		Using   string
+		Expect  Duration
+		Err     error
+	}{
+		{Profile: XSDDuration, Using: "P1Y2M3DT10H30M", Expect: Duration{Years: 1, Months: 2, Days: 3, Hours: 10, Minutes: 30}},
+		{Profile: XSDDuration, Using: "-P120D", Expect: Duration{Negative: true, Days: 120}},
+		{Profile: XSDDuration, Using: "PT1.5S", Expect: Duration{Seconds: 1, Nanoseconds: 500000000}},
+		{Profile: XSDDuration, Using: "P1W", Err: UnexpectedCharacterError{Character: 'W'}},
+		{Profile: XSDDuration, Using: "P0001-02-03", Err: UnexpectedCharacterError{Character: '-'}},
+		{Profile: JSONSchemaDuration, Using: "P4W", Expect: Duration{Weeks: 4}},
+		{Profile: JSONSchemaDuration, Using: "P1DT12H", Expect: Duration{Days: 1, Hours: 12}},
+		{Profile: JSONSchemaDuration, Using: "PT1.5S", Err: UnexpectedCharacterError{Character: '.'}},
+		{Profile: JSONSchemaDuration, Using: "-P1D", Err: UnexpectedCharacterError{Character: '-'}},
+		{Profile: JSONSchemaDuration, Using: "P1W1D", Err: ErrDurationWeeks},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Profile.Name+" "+c.Using, func(t *testing.T) {
+			d, err := c.Profile.ParseString(c.Using)
+			if c.Err != nil {
+				if !errors.Is(err, c.Err) {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != c.Expect {
+				t.Errorf("ParseString = %+v; want %+v", d, c.Expect)
+			}
+		})
+	}
+}
+
+func TestDurationProfileFormat(t *testing.T) {
+	var cases = []struct {
+		Profile DurationProfile
+		Using   Duration
+		Expect  string
+		Err     error
+	}{
+		{Profile: XSDDuration, Using: Duration{Negative: true, Days: 1}, Expect: "-P1D"},
+		{Profile: XSDDuration, Using: Duration{Weeks: 2, Hours: 1}, Expect: "P14DT1H"},
+		{Profile: XSDDuration, Using: Duration{Weeks: 2}, Expect: "P14D"},
+		{Profile: JSONSchemaDuration, Using: Duration{Weeks: 2}, Expect: "P2W"},
+		{Profile: JSONSchemaDuration, Using: Duration{Weeks: 1, Days: 1}, Expect: "P8D"},
+		{Profile: JSONSchemaDuration, Using: Duration{Negative: true, Days: 1}, Err: ErrNegativeDuration},
+		{Profile: JSONSchemaDuration, Using: Duration{Nanoseconds: 1}, Err: ErrUnsupportedPrecision},
+		{Profile: JSONSchemaDuration, Using: Duration{Negative: true}, Expect: "PT0S"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Profile.Name+" "+c.Expect, func(t *testing.T) {
+			s, err := c.Profile.Format(c.Using)
+			if c.Err != nil {
+				if !errors.Is(err, c.Err) {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s != c.Expect {
+				t.Errorf("Format = %q; want %q", s, c.Expect)
+			}
+		})
+	}
+}
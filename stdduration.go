@@ -0,0 +1,172 @@
+package iso8601
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = StdDuration{}
+	_ json.Unmarshaler         = &StdDuration{}
+	_ encoding.TextMarshaler   = StdDuration{}
+	_ encoding.TextUnmarshaler = &StdDuration{}
+	_ driver.Valuer            = StdDuration{}
+	_ sql.Scanner              = &StdDuration{}
+
+	_ json.Unmarshaler         = &LenientStdDuration{}
+	_ encoding.TextUnmarshaler = &LenientStdDuration{}
+	_ sql.Scanner              = &LenientStdDuration{}
+)
+
+// StdDuration is a helper object for encoding a time.Duration as an ISO8601 duration string, such as `PT30S`,
+// in JSON, text and SQL.
+//
+// Only durations that are an exact amount of time can be decoded. Durations with years or months are
+// rejected with ErrCalendarDuration, and durations with days or weeks with ErrNominalDuration,
+// since a day is not always 24 hours long. Use `PT24H` for an exact day.
+type StdDuration struct {
+	time.Duration
+}
+
+// String returns the canonical ISO8601 representation of the duration, such as `PT1H30M`.
+func (d StdDuration) String() string {
+	return DurationFromStd(d.Duration).String()
+}
+
+// MarshalText encodes the duration as an ISO8601 duration.
+func (d StdDuration) MarshalText() ([]byte, error) {
+	return DurationFromStd(d.Duration).AppendFormat(nil), nil
+}
+
+// UnmarshalText decodes an ISO8601 duration.
+func (d *StdDuration) UnmarshalText(b []byte) error {
+	var err error
+	d.Duration, err = parseStdDuration(b, false)
+	return err
+}
+
+// MarshalJSON encodes the duration as a JSON string containing an ISO8601 duration.
+func (d StdDuration) MarshalJSON() ([]byte, error) {
+	return marshalStdDurationJSON(d.Duration), nil
+}
+
+// UnmarshalJSON decodes a JSON string or null into the duration.
+func (d *StdDuration) UnmarshalJSON(b []byte) error {
+	return unmarshalStdDurationJSON(&d.Duration, b, false)
+}
+
+// Value implements driver.Valuer by encoding the duration as an ISO8601 duration string.
+func (d StdDuration) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner by decoding an ISO8601 duration from a string or byte slice.
+func (d *StdDuration) Scan(src interface{}) error {
+	return scanStdDuration(&d.Duration, src, false)
+}
+
+// LenientStdDuration is a StdDuration that also decodes durations in the format accepted by time.ParseDuration, such as `30s`.
+// It eases migrating data encoded as Go durations to ISO8601. It is always encoded as an ISO8601 duration.
+type LenientStdDuration StdDuration
+
+// String returns the canonical ISO8601 representation of the duration, such as `PT1H30M`.
+func (d LenientStdDuration) String() string {
+	return StdDuration(d).String()
+}
+
+// MarshalText encodes the duration as an ISO8601 duration.
+func (d LenientStdDuration) MarshalText() ([]byte, error) {
+	return StdDuration(d).MarshalText()
+}
+
+// UnmarshalText decodes an ISO8601 or Go duration.
+func (d *LenientStdDuration) UnmarshalText(b []byte) error {
+	var err error
+	d.Duration, err = parseStdDuration(b, true)
+	return err
+}
+
+// MarshalJSON encodes the duration as a JSON string containing an ISO8601 duration.
+func (d LenientStdDuration) MarshalJSON() ([]byte, error) {
+	return marshalStdDurationJSON(d.Duration), nil
+}
+
+// UnmarshalJSON decodes a JSON string or null into the duration.
+func (d *LenientStdDuration) UnmarshalJSON(b []byte) error {
+	return unmarshalStdDurationJSON(&d.Duration, b, true)
+}
+
+// Value implements driver.Valuer by encoding the duration as an ISO8601 duration string.
+func (d LenientStdDuration) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner by decoding an ISO8601 or Go duration from a string or byte slice.
+func (d *LenientStdDuration) Scan(src interface{}) error {
+	return scanStdDuration(&d.Duration, src, true)
+}
+
+// parseStdDuration parses an ISO8601 duration as a time.Duration.
+// If lenient is true then input that is not an ISO8601 duration is parsed by time.ParseDuration.
+func parseStdDuration[T input](inp T, lenient bool) (time.Duration, error) {
+	if lenient && !isISODuration(inp) {
+		return time.ParseDuration(string(inp))
+	}
+
+	d, err := parseDuration(inp, &ParseOptions{AllowSignedDuration: true})
+	if err != nil {
+		return 0, err
+	}
+	std, err := d.Std()
+	if err != nil {
+		return 0, err
+	}
+	if d.Weeks != 0 || d.Days != 0 {
+		return 0, ErrNominalDuration
+	}
+	return std, nil
+}
+
+// isISODuration reports whether inp starts like an ISO8601 duration, with an optional sign followed by `P`.
+func isISODuration[T input](inp T) bool {
+	if len(inp) > 0 && (inp[0] == '+' || inp[0] == '-') {
+		inp = inp[1:]
+	}
+	return len(inp) > 0 && inp[0] == 'P'
+}
+
+func marshalStdDurationJSON(d time.Duration) []byte {
+	b := append(make([]byte, 0, 32), '"')
+	b = DurationFromStd(d).AppendFormat(b)
+	return append(b, '"')
+}
+
+func unmarshalStdDurationJSON(d *time.Duration, b []byte, lenient bool) error {
+	// Do not process null types
+	if null(b) {
+		return nil
+	}
+	b, err := unquote(b)
+	if err != nil {
+		return err
+	}
+	*d, err = parseStdDuration(b, lenient)
+	return err
+}
+
+func scanStdDuration(d *time.Duration, src interface{}, lenient bool) error {
+	var err error
+	switch v := src.(type) {
+	case string:
+		*d, err = parseStdDuration(v, lenient)
+	case []byte:
+		*d, err = parseStdDuration(v, lenient)
+	default:
+		return fmt.Errorf("iso8601: Cannot scan %T into a duration", src)
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type TestConfig struct {
+	Timeout  StdDuration
+	Interval *StdDuration
+}
+
+func TestStdDuration_JSON(t *testing.T) {
+	b, err := json.Marshal(TestConfig{Timeout: StdDuration{30 * time.Second}, Interval: &StdDuration{-90 * time.Minute}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Timeout":"PT30S","Interval":"-PT1H30M"}` {
+		t.Errorf("Marshal = %s", s)
+	}
+
+	var c TestConfig
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Timeout.Duration != 30*time.Second || c.Interval == nil || c.Interval.Duration != -90*time.Minute {
+		t.Errorf("Unmarshal = %s, %v", c.Timeout, c.Interval)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Timeout":"PT24H0.5S","Interval":null}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Timeout.Duration != 24*time.Hour+500*time.Millisecond {
+		t.Errorf("Timeout = %s; want 24h0m0.5s", c.Timeout.Duration)
+	}
+
+	for _, tc := range []struct {
+		Using  string
+		Expect error
+	}{
+		{Using: `{"Timeout":"P1M"}`, Expect: ErrCalendarDuration},
+		{Using: `{"Timeout":"P1Y"}`, Expect: ErrCalendarDuration},
+		{Using: `{"Timeout":"P1D"}`, Expect: ErrNominalDuration},
+		{Using: `{"Timeout":"P1W"}`, Expect: ErrNominalDuration},
+		{Using: `{"Timeout":"P1DT1H"}`, Expect: ErrNominalDuration},
+		{Using: `{"Timeout":"30s"}`, Expect: UnexpectedCharacterError{Character: '3'}},
+		{Using: `{"Timeout":30}`, Expect: ErrNotString},
+	} {
+		if err := json.Unmarshal([]byte(tc.Using), &c); !errors.Is(err, tc.Expect) {
+			t.Errorf("%s: expected error %v, got %v", tc.Using, tc.Expect, err)
+		}
+	}
+}
+
+func TestStdDuration_Text(t *testing.T) {
+	d := StdDuration{36 * time.Hour}
+	b, err := d.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "PT36H" {
+		t.Errorf("MarshalText = %s; want PT36H", b)
+	}
+	var n StdDuration
+	if err := n.UnmarshalText(b); err != nil || n != d {
+		t.Errorf("UnmarshalText = %s, %v; want %s", n.Duration, err, d.Duration)
+	}
+}
+
+func TestStdDuration_SQL(t *testing.T) {
+	v, err := StdDuration{time.Minute}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "PT1M" {
+		t.Errorf("Value = %v; want PT1M", v)
+	}
+
+	var d StdDuration
+	if err := d.Scan("PT2M"); err != nil || d.Duration != 2*time.Minute {
+		t.Errorf("Scan(string) = %s, %v", d.Duration, err)
+	}
+	if err := d.Scan([]byte("PT3M")); err != nil || d.Duration != 3*time.Minute {
+		t.Errorf("Scan([]byte) = %s, %v", d.Duration, err)
+	}
+	if err := d.Scan(int64(1)); err == nil {
+		t.Error("Expected an error scanning an integer")
+	}
+}
+
+func TestLenientStdDuration(t *testing.T) {
+	var c struct {
+		A, B, C LenientStdDuration
+	}
+	if err := json.Unmarshal([]byte(`{"A":"30s","B":"PT1M","C":"-1h30m"}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.A.Duration != 30*time.Second || c.B.Duration != time.Minute || c.C.Duration != -90*time.Minute {
+		t.Errorf("Unmarshal = %s, %s, %s", c.A.Duration, c.B.Duration, c.C.Duration)
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"A":"PT30S","B":"PT1M","C":"-PT1H30M"}` {
+		t.Errorf("Marshal = %s", s)
+	}
+
+	var d LenientStdDuration
+	if err := d.Scan("5m"); err != nil || d.Duration != 5*time.Minute {
+		t.Errorf("Scan = %s, %v", d.Duration, err)
+	}
+	if err := d.UnmarshalText([]byte("P1M")); !errors.Is(err, ErrCalendarDuration) {
+		t.Errorf("Expected error %v, got %v", ErrCalendarDuration, err)
+	}
+	if err := d.UnmarshalText([]byte("P2D")); !errors.Is(err, ErrNominalDuration) {
+		t.Errorf("Expected error %v, got %v", ErrNominalDuration, err)
+	}
+}
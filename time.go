@@ -27,3 +27,35 @@ func daysInYear(year int) int {
 	}
 	return 365
 }
+
+// isoWeekday returns the ISO 8601 number of the day of the week, from Monday (1) to Sunday (7).
+func isoWeekday(d time.Weekday) int {
+	if d == time.Sunday {
+		return 7
+	}
+	return int(d)
+}
+
+// weeksInYear is the number of weeks in an ISO 8601 week-numbering year.
+// A year has 53 weeks if it starts on a Thursday, or if it is a leap year that starts on a Wednesday.
+func weeksInYear(year int) int {
+	switch time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Thursday:
+		return 53
+	case time.Wednesday:
+		if isLeap(year) {
+			return 53
+		}
+	}
+	return 52
+}
+
+// weekDateDay converts an ISO 8601 week date to a day of January in the given year,
+// suitable for normalisation by time.Date. The result may be before the 1st of January
+// or after the end of January if the week date lies outside of it.
+func weekDateDay(year, week, weekday int) int {
+	// The 4th of January is always in the first week of the year.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC).Weekday()
+	monday := 4 - (isoWeekday(jan4) - 1)
+	return monday + (week-1)*7 + (weekday - 1)
+}
@@ -0,0 +1,320 @@
+package iso8601
+
+import (
+	"time"
+)
+
+// ReferencePolicy selects how the components missing from a truncated date are filled in from a reference time.
+type ReferencePolicy uint8
+
+const (
+	// NearestPast chooses the latest date that is on or before the day of the reference time.
+	NearestPast ReferencePolicy = iota
+	// NearestFuture chooses the earliest date that is on or after the day of the reference time.
+	NearestFuture
+	// CenturyPivot places a two digit year in the hundred years starting at the pivot year,
+	// and takes any other missing component from the reference time.
+	CenturyPivot
+)
+
+// TruncatedOptions parses the truncated representations of ISO 8601:2000,
+// filling in the missing high-order components from a reference time.
+type TruncatedOptions struct {
+	// Reference is the time relative to which missing components are filled in.
+	// Parsed dates are returned at the start of the day in the location of Reference.
+	Reference time.Time
+	// Policy selects how missing components are filled in.
+	Policy ReferencePolicy
+	// Pivot is the first year of the hundred years that a two digit year is placed in when using CenturyPivot,
+	// such as 1950 so that `49` is 2049 and `50` is 1950.
+	// If Pivot is zero, the hundred years start 50 years before the year of Reference.
+	Pivot int
+}
+
+// pivot returns the first year of the hundred years that a two digit year is placed in when using CenturyPivot.
+func (o TruncatedOptions) pivot() int {
+	if o.Pivot == 0 {
+		return o.Reference.Year() - 50
+	}
+	return o.Pivot
+}
+
+// truncatedUnit is the high-order unit missing from a truncated date.
+type truncatedUnit uint8
+
+const (
+	missingCentury truncatedUnit = iota
+	missingYear
+	missingMonth
+	missingWeekYear
+)
+
+// truncatedDate are the components of a truncated date.
+type truncatedDate struct {
+	missing          truncatedUnit
+	year, month, day int
+	week, weekday    int
+}
+
+// maxTruncatedSteps is the number of years or months searched for a valid date,
+// which is enough to reach a February 29th or a 53rd week from any year.
+const maxTruncatedSteps = 12
+
+// Parse parses a truncated date, or any complete date and time accepted by ParseInLocation in the location of o.Reference.
+// This function expects truncated input that matches:
+//
+//	85-04-12, 850412 (two digit year)
+//	-05-01, --05-01, --0501 (month and day)
+//	--05 (month)
+//	---12 (day of the month)
+//	-W15-2, -W152, -W15 (week and day of the week)
+//
+// A truncated date cannot be followed by a time, as its missing components would be ambiguous.
+// If any component is not within the expected range, or the components do not form a date for the reference time
+// when using CenturyPivot, then an *iso8601.RangeError is returned.
+func (o TruncatedOptions) Parse(inp []byte) (time.Time, error) {
+	return parseTruncated(inp, o)
+}
+
+// ParseString parses a truncated date string. See Parse for the accepted input.
+func (o TruncatedOptions) ParseString(inp string) (time.Time, error) {
+	return parseTruncated(inp, o)
+}
+
+func parseTruncated[T input](inp T, o TruncatedOptions) (time.Time, error) {
+	loc := o.Reference.Location()
+	d, ok, err := scanTruncated(inp)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		// A complete date is never a truncated date, so a truncated date before the time is rejected
+		// rather than being parsed as a year of the first century.
+		for i := 0; i < len(inp); i++ {
+			if c := inp[i]; c == 'T' || c == 't' || c == ' ' {
+				if _, ok, _ := scanTruncated(inp[:i]); ok {
+					return time.Time{}, newUnexpectedCharacterError(c)
+				}
+				break
+			}
+		}
+		return parseInLocation(inp, loc, &ParseOptions{})
+	}
+
+	if o.Policy == CenturyPivot {
+		if t, ok := d.candidate(o, 0); ok {
+			return t, nil
+		}
+		return time.Time{}, d.rangeError(string(inp), o)
+	}
+
+	refYear, refMonth, refDay := o.Reference.Date()
+	ref := time.Date(refYear, refMonth, refDay, 0, 0, 0, 0, loc)
+	steps := maxTruncatedSteps
+	if d.missing == missingCentury {
+		steps = 2
+	}
+	for k := 0; k <= steps; k++ {
+		step := k
+		if o.Policy == NearestPast {
+			step = -k
+		}
+		t, ok := d.candidate(o, step)
+		if !ok {
+			continue
+		}
+		if (o.Policy == NearestPast && !t.After(ref)) || (o.Policy == NearestFuture && !t.Before(ref)) {
+			return t, nil
+		}
+	}
+	return time.Time{}, d.rangeError(string(inp), o)
+}
+
+// candidate returns the date of d that is step years, months or centuries after those of o.Reference,
+// and whether it is valid.
+func (d truncatedDate) candidate(o TruncatedOptions, step int) (time.Time, bool) {
+	loc := o.Reference.Location()
+	year, month, day := d.year, d.month, d.day
+	switch d.missing {
+	case missingCentury:
+		if o.Policy == CenturyPivot {
+			year = o.pivot() + ((year-o.pivot())%100+100)%100
+		} else {
+			year += (o.Reference.Year()/100 + step) * 100
+		}
+	case missingYear:
+		year = o.Reference.Year() + step
+	case missingMonth:
+		t := time.Date(o.Reference.Year(), o.Reference.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		year, month = t.Year(), int(t.Month())
+	case missingWeekYear:
+		year, _ = o.Reference.ISOWeek()
+		year += step
+		if d.week > weeksInYear(year) {
+			return time.Time{}, false
+		}
+		return time.Date(year, time.January, weekDateDay(year, d.week, d.weekday), 0, 0, 0, 0, loc), true
+	}
+	if day > daysIn(time.Month(month), year) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
+}
+
+// rangeError returns the error for a truncated date that has no valid date relative to the reference time.
+func (d truncatedDate) rangeError(inp string, o TruncatedOptions) error {
+	year, month := d.year, d.month
+	switch d.missing {
+	case missingCentury:
+		year = o.pivot() + ((d.year-o.pivot())%100+100)%100
+	case missingYear:
+		year = o.Reference.Year()
+	case missingMonth:
+		year, month = o.Reference.Year(), int(o.Reference.Month())
+	case missingWeekYear:
+		year, _ = o.Reference.ISOWeek()
+		return &RangeError{
+			Value:   inp,
+			Element: "week",
+			Given:   d.week,
+			Min:     1,
+			Max:     weeksInYear(year),
+		}
+	}
+	return &RangeError{
+		Value:   inp,
+		Element: "day",
+		Given:   d.day,
+		Min:     1,
+		Max:     daysIn(time.Month(month), year),
+	}
+}
+
+// scanTruncated scans a truncated date.
+// It returns false if inp is not a truncated date, and should be parsed as a complete date and time instead.
+func scanTruncated[T input](inp T) (truncatedDate, bool, error) {
+	var d truncatedDate
+	var i int
+	var err error
+
+	switch {
+	case len(inp) >= 3 && inp[0] == '-' && inp[1] == '-' && inp[2] == '-':
+		// ---DD
+		d.missing = missingMonth
+		if d.day, err = readDigits(inp, 3, 2); err != nil {
+			return truncatedDate{}, false, err
+		}
+		i = 5
+	case len(inp) >= 2 && inp[0] == '-' && inp[1] == '-':
+		// --MM, --MM-DD or --MMDD
+		d.missing = missingYear
+		if d.month, err = readDigits(inp, 2, 2); err != nil {
+			return truncatedDate{}, false, err
+		}
+		d.day = 1
+		i = 4
+		if i < len(inp) {
+			if inp[i] == '-' {
+				i++
+			}
+			if d.day, err = readDigits(inp, i, 2); err != nil {
+				return truncatedDate{}, false, err
+			}
+			i += 2
+		}
+	case len(inp) >= 2 && inp[0] == '-' && inp[1] == 'W':
+		// -Www, -Www-D or -WwwD
+		d.missing = missingWeekYear
+		if d.week, err = readDigits(inp, 2, 2); err != nil {
+			return truncatedDate{}, false, err
+		}
+		d.weekday = 1
+		i = 4
+		if i < len(inp) {
+			if inp[i] == '-' {
+				i++
+			}
+			if d.weekday, err = readDigits(inp, i, 1); err != nil {
+				return truncatedDate{}, false, err
+			}
+			i++
+		}
+	case len(inp) == 6 && inp[0] == '-' && inp[3] == '-':
+		// -MM-DD, which is read as a month and day as written by legacy feeds rather than the year and month of ISO 8601:2000.
+		d.missing = missingYear
+		if d.month, err = readDigits(inp, 1, 2); err != nil {
+			return truncatedDate{}, false, err
+		}
+		if d.day, err = readDigits(inp, 4, 2); err != nil {
+			return truncatedDate{}, false, err
+		}
+		i = 6
+	case len(inp) == 8 && inp[2] == '-' && inp[5] == '-', len(inp) == 6 && isDigits(inp):
+		// YY-MM-DD or YYMMDD
+		d.missing = missingCentury
+		extended := len(inp) == 8
+		for _, v := range []*int{&d.year, &d.month, &d.day} {
+			if *v, err = readDigits(inp, i, 2); err != nil {
+				return truncatedDate{}, false, err
+			}
+			i += 2
+			if extended && i < len(inp) {
+				if inp[i] != '-' {
+					return truncatedDate{}, false, newUnexpectedCharacterError(inp[i])
+				}
+				i++
+			}
+		}
+	default:
+		return truncatedDate{}, false, nil
+	}
+	if i < len(inp) {
+		return truncatedDate{}, false, newUnexpectedCharacterError(inp[i])
+	}
+
+	switch {
+	case d.missing != missingMonth && d.missing != missingWeekYear && (d.month < 1 || d.month > 12):
+		return truncatedDate{}, false, &RangeError{
+			Value:   string(inp),
+			Element: "month",
+			Given:   d.month,
+			Min:     1,
+			Max:     12,
+		}
+	case d.missing != missingWeekYear && (d.day < 1 || d.day > 31):
+		return truncatedDate{}, false, &RangeError{
+			Value:   string(inp),
+			Element: "day",
+			Given:   d.day,
+			Min:     1,
+			Max:     31,
+		}
+	case d.missing == missingWeekYear && (d.week < 1 || d.week > 53):
+		return truncatedDate{}, false, &RangeError{
+			Value:   string(inp),
+			Element: "week",
+			Given:   d.week,
+			Min:     1,
+			Max:     53,
+		}
+	case d.missing == missingWeekYear && (d.weekday < 1 || d.weekday > 7):
+		return truncatedDate{}, false, &RangeError{
+			Value:   string(inp),
+			Element: "weekday",
+			Given:   d.weekday,
+			Min:     1,
+			Max:     7,
+		}
+	}
+	return d, true, nil
+}
+
+// isDigits reports whether every byte of inp is a digit.
+func isDigits[T input](inp T) bool {
+	for i := 0; i < len(inp); i++ {
+		if !isDigit(inp[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package iso8601
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTruncated(t *testing.T) {
+	ref := time.Date(2023, 6, 15, 10, 30, 0, 0, time.UTC)
+	var cases = []struct {
+		Using  string
+		Policy ReferencePolicy
+		Pivot  int
+		Expect time.Time
+	}{
+		{Using: "85-04-12", Policy: NearestPast, Expect: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "850412", Policy: NearestPast, Expect: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "23-06-15", Policy: NearestPast, Expect: time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)},
+		{Using: "23-06-16", Policy: NearestPast, Expect: time.Date(1923, 6, 16, 0, 0, 0, 0, time.UTC)},
+		{Using: "85-04-12", Policy: NearestFuture, Expect: time.Date(2085, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "85-04-12", Policy: CenturyPivot, Pivot: 1950, Expect: time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "49-04-12", Policy: CenturyPivot, Pivot: 1950, Expect: time.Date(2049, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "50-04-12", Policy: CenturyPivot, Pivot: 1950, Expect: time.Date(1950, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "73-04-12", Policy: CenturyPivot, Expect: time.Date(1973, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "72-04-12", Policy: CenturyPivot, Expect: time.Date(2072, 4, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "-05-01", Policy: NearestPast, Expect: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "-05-01", Policy: NearestFuture, Expect: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "--05-01", Policy: CenturyPivot, Expect: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "--0701", Policy: NearestPast, Expect: time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "--02-29", Policy: NearestPast, Expect: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{Using: "--02-29", Policy: NearestFuture, Expect: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{Using: "--05", Policy: NearestPast, Expect: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "--05", Policy: NearestFuture, Expect: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "---12", Policy: NearestPast, Expect: time.Date(2023, 6, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "---12", Policy: NearestFuture, Expect: time.Date(2023, 7, 12, 0, 0, 0, 0, time.UTC)},
+		{Using: "---31", Policy: NearestPast, Expect: time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)},
+		{Using: "---31", Policy: NearestFuture, Expect: time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC)},
+		{Using: "---15", Policy: NearestFuture, Expect: time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)},
+		{Using: "-W15-2", Policy: NearestPast, Expect: time.Date(2023, 4, 11, 0, 0, 0, 0, time.UTC)},
+		{Using: "-W152", Policy: NearestFuture, Expect: time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)},
+		{Using: "-W15", Policy: CenturyPivot, Expect: time.Date(2023, 4, 10, 0, 0, 0, 0, time.UTC)},
+		{Using: "-W53", Policy: NearestPast, Expect: time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC)},
+		{Using: "2023-01-02", Policy: NearestFuture, Expect: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{Using: "2023-01-02T10:00", Policy: NearestFuture, Expect: time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)},
+		{Using: "2020-6", Policy: NearestPast, Expect: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{Using: "+20201", Policy: NearestPast, Expect: time.Date(20201, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			o := TruncatedOptions{Reference: ref, Policy: c.Policy, Pivot: c.Pivot}
+			d, err := o.ParseString(c.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !d.Equal(c.Expect) {
+				t.Errorf("ParseString = %s; want %s", d, c.Expect)
+			}
+			if b, err := o.Parse([]byte(c.Using)); err != nil || !b.Equal(d) {
+				t.Errorf("Parse = %s, %v; want %s", b, err, d)
+			}
+		})
+	}
+}
+
+func TestParseTruncatedLocation(t *testing.T) {
+	loc := time.FixedZone("", 5*3600)
+	o := TruncatedOptions{Reference: time.Date(2023, 6, 15, 1, 0, 0, 0, loc)}
+	d, err := o.ParseString("---15")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2023, 6, 15, 0, 0, 0, 0, loc); !d.Equal(want) || d.Location() != loc {
+		t.Errorf("ParseString = %s; want %s", d, want)
+	}
+}
+
+func TestParseTruncatedErrors(t *testing.T) {
+	ref := time.Date(2023, 4, 15, 0, 0, 0, 0, time.UTC)
+	var cases = []struct {
+		Using  string
+		Policy ReferencePolicy
+		Err    error
+	}{
+		{Using: "--13", Err: &RangeError{Element: "month", Given: 13}},
+		{Using: "--05-32", Err: &RangeError{Element: "day", Given: 32}},
+		{Using: "---00", Err: &RangeError{Element: "day", Given: 0}},
+		{Using: "-W54", Err: &RangeError{Element: "week", Given: 54}},
+		{Using: "-W15-8", Err: &RangeError{Element: "weekday", Given: 8}},
+		{Using: "---31", Policy: CenturyPivot, Err: &RangeError{Element: "day", Given: 31}},
+		{Using: "-W53", Policy: CenturyPivot, Err: &RangeError{Element: "week", Given: 53}},
+		{Using: "--02-29", Policy: CenturyPivot, Err: &RangeError{Element: "day", Given: 29}},
+		{Using: "---1", Err: ErrUnexpectedEnd},
+		{Using: "--05-", Err: ErrUnexpectedEnd},
+		{Using: "---123", Err: UnexpectedCharacterError{Character: '3'}},
+		{Using: "85-04/12", Err: UnexpectedCharacterError{Character: '/'}},
+		{Using: "-W1x", Err: UnexpectedCharacterError{Character: 'x'}},
+		{Using: "85-04-12T10:00", Err: UnexpectedCharacterError{Character: 'T'}},
+		{Using: "850412T1000", Err: UnexpectedCharacterError{Character: 'T'}},
+		{Using: "--05-01T10:00", Err: UnexpectedCharacterError{Character: 'T'}},
+		{Using: "85-04-12 10:00", Err: UnexpectedCharacterError{Character: ' '}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			_, err := TruncatedOptions{Reference: ref, Policy: c.Policy}.ParseString(c.Using)
+			if want, ok := c.Err.(*RangeError); ok {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || rangeErr.Element != want.Element || rangeErr.Given != want.Given {
+					t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+				}
+				return
+			}
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+		})
+	}
+}
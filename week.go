@@ -0,0 +1,221 @@
+package iso8601
+
+import (
+	"encoding"
+	"encoding/json"
+	"time"
+)
+
+var (
+	_ json.Marshaler           = WeekDate{}
+	_ json.Unmarshaler         = &WeekDate{}
+	_ encoding.TextMarshaler   = WeekDate{}
+	_ encoding.TextUnmarshaler = &WeekDate{}
+)
+
+// WeekDate is a date in the ISO 8601 week-numbering calendar, such as `2020-W53-5`.
+// Weeks start on a Monday, and the first week of a year is the week containing its first Thursday.
+type WeekDate struct {
+	// Year is the week-numbering year, which can differ from the calendar year for dates near the start or end of a year.
+	Year int
+	// Week is the week of the year, between 1 and 52 or 53.
+	Week int
+	// Weekday is the day of the week.
+	Weekday time.Weekday
+}
+
+// WeeksInYear returns the number of weeks (52 or 53) in the given ISO 8601 week-numbering year.
+func WeeksInYear(year int) int {
+	return weeksInYear(year)
+}
+
+// WeekDateOf returns the ISO 8601 week date of t in its location.
+func WeekDateOf(t time.Time) WeekDate {
+	year, week := t.ISOWeek()
+	return WeekDate{Year: year, Week: week, Weekday: t.Weekday()}
+}
+
+// Time returns the start of the day of w in the given location.
+func (w WeekDate) Time(loc *time.Location) time.Time {
+	return time.Date(w.Year, time.January, weekDateDay(w.Year, w.Week, isoWeekday(w.Weekday)), 0, 0, 0, 0, loc)
+}
+
+// IsValid reports whether w is a valid week date.
+func (w WeekDate) IsValid() bool {
+	return w.Week >= 1 && w.Week <= weeksInYear(w.Year) && w.Weekday >= time.Sunday && w.Weekday <= time.Saturday
+}
+
+// StartOfWeek returns the Monday of the week of w.
+func (w WeekDate) StartOfWeek() WeekDate {
+	w.Weekday = time.Monday
+	return w
+}
+
+// EndOfWeek returns the Sunday of the week of w.
+func (w WeekDate) EndOfWeek() WeekDate {
+	w.Weekday = time.Sunday
+	return w
+}
+
+// AddWeeks returns the week date n weeks after w, which may be in another week-numbering year.
+// The day of the week is unchanged. A negative n returns an earlier week date.
+func (w WeekDate) AddWeeks(n int) WeekDate {
+	return WeekDateOf(w.Time(time.UTC).AddDate(0, 0, 7*n))
+}
+
+// String returns w in the extended format `YYYY-Www-D`.
+func (w WeekDate) String() string {
+	return string(w.AppendFormat(make([]byte, 0, 10), false))
+}
+
+// AppendFormat appends w to dst in the basic (`YYYYWwwD`) or extended (`YYYY-Www-D`) format
+// and returns the extended buffer.
+func (w WeekDate) AppendFormat(dst []byte, basic bool) []byte {
+	dst = appendYear(dst, w.Year)
+	if !basic {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, 'W')
+	dst = appendInt(dst, w.Week, 2)
+	if !basic {
+		dst = append(dst, '-')
+	}
+	return appendInt(dst, isoWeekday(w.Weekday), 1)
+}
+
+// MarshalText encodes w in the extended format `YYYY-Www-D`.
+func (w WeekDate) MarshalText() ([]byte, error) {
+	return w.AppendFormat(nil, false), nil
+}
+
+// UnmarshalText decodes a week date in the basic or extended format.
+func (w *WeekDate) UnmarshalText(b []byte) error {
+	var err error
+	*w, err = ParseWeekDate(b)
+	return err
+}
+
+// MarshalJSON encodes w as a JSON string in the extended format `YYYY-Www-D`.
+func (w WeekDate) MarshalJSON() ([]byte, error) {
+	b := append(make([]byte, 0, 12), '"')
+	b = w.AppendFormat(b, false)
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decodes a JSON string or null into a week date.
+func (w *WeekDate) UnmarshalJSON(b []byte) error {
+	// Do not process null types
+	if null(b) {
+		return nil
+	}
+	b, err := unquote(b)
+	if err != nil {
+		return err
+	}
+	return w.UnmarshalText(b)
+}
+
+// ParseWeekDate parses an ISO 8601 week date.
+// This function expects input that matches:
+//
+//	2020-W53-5
+//	2020W535
+//	2020-W53 (the Monday of the week)
+//	2020W53 (the Monday of the week)
+//
+// If the week or day of the week is not within the expected range then an *iso8601.RangeError is returned.
+func ParseWeekDate(inp []byte) (WeekDate, error) {
+	return parseWeekDate(inp)
+}
+
+// ParseWeekDateString parses an ISO 8601 week date string. See ParseWeekDate for the accepted input.
+func ParseWeekDateString(inp string) (WeekDate, error) {
+	return parseWeekDate(inp)
+}
+
+func parseWeekDate[T input](inp T) (WeekDate, error) {
+	w, _, i, err := scanWeekDate(inp)
+	if err != nil {
+		return WeekDate{}, err
+	}
+	if i < len(inp) {
+		return WeekDate{}, newUnexpectedCharacterError(inp[i])
+	}
+	return w, nil
+}
+
+// scanWeekDate scans a week date at the start of inp.
+// It returns the week date, whether the day of the week was given, and the position after the week date.
+func scanWeekDate[T input](inp T) (WeekDate, bool, int, error) {
+	var w WeekDate
+	var i int
+
+	var neg bool
+	if len(inp) > 0 && (inp[0] == '+' || inp[0] == '-') {
+		neg = inp[0] == '-'
+		i++
+	}
+	start := i
+	for ; i < len(inp) && isDigit(inp[i]); i++ {
+		w.Year = w.Year*10 + int(inp[i]-'0')
+	}
+	if i-start < 4 {
+		return WeekDate{}, false, 0, unexpected(inp, i)
+	}
+	if neg {
+		w.Year = -w.Year
+	}
+
+	extended := i < len(inp) && inp[i] == '-'
+	if extended {
+		i++
+	}
+	if i == len(inp) || inp[i] != 'W' {
+		return WeekDate{}, false, 0, unexpected(inp, i)
+	}
+	i++
+
+	for n := 0; n < 2; n, i = n+1, i+1 {
+		if i == len(inp) || !isDigit(inp[i]) {
+			return WeekDate{}, false, 0, unexpected(inp, i)
+		}
+		w.Week = w.Week*10 + int(inp[i]-'0')
+	}
+
+	// The day of the week follows a dash in the extended format, and directly in the basic format.
+	var d = 1
+	var weekday bool
+	if i < len(inp) && ((extended && inp[i] == '-') || (!extended && isDigit(inp[i]))) {
+		if extended {
+			i++
+		}
+		if i == len(inp) || !isDigit(inp[i]) {
+			return WeekDate{}, false, 0, unexpected(inp, i)
+		}
+		d = int(inp[i] - '0')
+		weekday = true
+		i++
+	}
+
+	switch {
+	case w.Week < 1 || w.Week > weeksInYear(w.Year):
+		return WeekDate{}, false, 0, &RangeError{
+			Value:   string(inp),
+			Element: "week",
+			Given:   w.Week,
+			Min:     1,
+			Max:     weeksInYear(w.Year),
+		}
+	case d < 1 || d > 7:
+		return WeekDate{}, false, 0, &RangeError{
+			Value:   string(inp),
+			Element: "weekday",
+			Given:   d,
+			Min:     1,
+			Max:     7,
+		}
+	}
+
+	w.Weekday = time.Weekday(d % 7)
+	return w, weekday, i, nil
+}
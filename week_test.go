@@ -0,0 +1,148 @@
+package iso8601
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWeeksInYear(t *testing.T) {
+	for year := 1900; year <= 2100; year++ {
+		_, want := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+		if got := WeeksInYear(year); got != want {
+			t.Errorf("WeeksInYear(%d) = %d; want %d", year, got, want)
+		}
+	}
+}
+
+func TestWeekDateRoundTrip(t *testing.T) {
+	start := time.Date(1999, time.December, 1, 0, 0, 0, 0, time.UTC)
+	for d := start; d.Year() < 2030; d = d.AddDate(0, 0, 1) {
+		w := WeekDateOf(d)
+		if !w.IsValid() {
+			t.Fatalf("WeekDateOf(%s) = %s is not valid", d, w)
+		}
+		if got := w.Time(time.UTC); !got.Equal(d) {
+			t.Fatalf("WeekDate(%s).Time() = %s; want %s", w, got, d)
+		}
+	}
+}
+
+var weekDateCases = []struct {
+	Using  string
+	Expect WeekDate
+	Time   time.Time
+}{
+	{Using: "2020-W53-5", Expect: WeekDate{Year: 2020, Week: 53, Weekday: time.Friday}, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+	{Using: "2020W535", Expect: WeekDate{Year: 2020, Week: 53, Weekday: time.Friday}, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+	{Using: "2009-W01-1", Expect: WeekDate{Year: 2009, Week: 1, Weekday: time.Monday}, Time: time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC)},
+	{Using: "2009-W53-7", Expect: WeekDate{Year: 2009, Week: 53, Weekday: time.Sunday}, Time: time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC)},
+	{Using: "2017-W17", Expect: WeekDate{Year: 2017, Week: 17, Weekday: time.Monday}, Time: time.Date(2017, 4, 24, 0, 0, 0, 0, time.UTC)},
+	{Using: "2017W17", Expect: WeekDate{Year: 2017, Week: 17, Weekday: time.Monday}, Time: time.Date(2017, 4, 24, 0, 0, 0, 0, time.UTC)},
+}
+
+func TestParseWeekDate(t *testing.T) {
+	for _, tc := range weekDateCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			w, err := ParseWeekDateString(tc.Using)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if w != tc.Expect {
+				t.Errorf("ParseWeekDate = %+v; want %+v", w, tc.Expect)
+			}
+			if d := w.Time(time.UTC); !d.Equal(tc.Time) {
+				t.Errorf("Time = %s; want %s", d, tc.Time)
+			}
+		})
+	}
+}
+
+func TestParseWeekDateInvalid(t *testing.T) {
+	var invalidCases = []struct {
+		Using   string
+		Expect  error
+		Element string
+	}{
+		{Using: "2020-W54-1", Element: "week"},
+		{Using: "2021-W53-1", Element: "week"},
+		{Using: "2021-W00-1", Element: "week"},
+		{Using: "2021-W01-8", Element: "weekday"},
+		{Using: "2021-W01-0", Element: "weekday"},
+		{Using: "2021-W1", Expect: ErrUnexpectedEnd},
+		{Using: "2021-", Expect: ErrUnexpectedEnd},
+		{Using: "2021-W011", Expect: UnexpectedCharacterError{Character: '1'}},
+		{Using: "2021W01-1", Expect: UnexpectedCharacterError{Character: '-'}},
+		{Using: "2021-W01-12", Expect: UnexpectedCharacterError{Character: '2'}},
+		{Using: "21-W01-1", Expect: UnexpectedCharacterError{Character: '-'}},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			_, err := ParseWeekDateString(tc.Using)
+			if tc.Element != "" {
+				var re *RangeError
+				if !errors.As(err, &re) || re.Element != tc.Element {
+					t.Fatalf("Expected a range error on %q, got %v", tc.Element, err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.Expect) {
+				t.Errorf("ParseWeekDate expected to return error %v (%T), got %v (%T)", tc.Expect, tc.Expect, err, err)
+			}
+		})
+	}
+}
+
+func TestWeekDateHelpers(t *testing.T) {
+	w := WeekDate{Year: 2020, Week: 53, Weekday: time.Wednesday}
+	if s := w.StartOfWeek().Time(time.UTC); !s.Equal(time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOfWeek = %s; want 2020-12-28", s)
+	}
+	if e := w.EndOfWeek().Time(time.UTC); !e.Equal(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("EndOfWeek = %s; want 2021-01-03", e)
+	}
+	if n := w.AddWeeks(1); n != (WeekDate{Year: 2021, Week: 1, Weekday: time.Wednesday}) {
+		t.Errorf("AddWeeks(1) = %s; want 2021-W01-3", n)
+	}
+	if n := w.AddWeeks(-53); n != (WeekDate{Year: 2019, Week: 52, Weekday: time.Wednesday}) {
+		t.Errorf("AddWeeks(-53) = %s; want 2019-W52-3", n)
+	}
+	if s := w.String(); s != "2020-W53-3" {
+		t.Errorf("String = %q; want %q", s, "2020-W53-3")
+	}
+	if b := w.AppendFormat(nil, true); string(b) != "2020W533" {
+		t.Errorf("AppendFormat = %q; want %q", b, "2020W533")
+	}
+}
+
+func TestWeekDateJSON(t *testing.T) {
+	type event struct {
+		Week  WeekDate
+		Later *WeekDate
+	}
+
+	b, err := json.Marshal(event{Week: WeekDate{Year: 2021, Week: 7, Weekday: time.Sunday}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Week":"2021-W07-7","Later":null}` {
+		t.Errorf("Marshal = %s", s)
+	}
+
+	var e event
+	if err := json.Unmarshal([]byte(`{"Week":"2021W077","Later":"2021-W08-1"}`), &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Week != (WeekDate{Year: 2021, Week: 7, Weekday: time.Sunday}) {
+		t.Errorf("Week = %s; want 2021-W07-7", e.Week)
+	}
+	if e.Later == nil || *e.Later != (WeekDate{Year: 2021, Week: 8, Weekday: time.Monday}) {
+		t.Errorf("Later = %v; want 2021-W08-1", e.Later)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Week":20210771}`), &e); !errors.Is(err, ErrNotString) {
+		t.Errorf("Expected %v, got %v", ErrNotString, err)
+	}
+}
@@ -0,0 +1,168 @@
+package iso8601
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ZoneKind describes the zone information given by an ISO8601 date string.
+type ZoneKind uint8
+
+const (
+	// ZoneAbsent indicates that no zone information was given.
+	ZoneAbsent ZoneKind = iota
+	// ZoneUTC indicates the UTC designator `Z`.
+	ZoneUTC
+	// ZoneOffset indicates a numeric offset from UTC, such as `+01:00`.
+	ZoneOffset
+	// ZoneUnknown indicates the RFC 3339 unknown local offset `-00:00`.
+	ZoneUnknown
+)
+
+func (k ZoneKind) String() string {
+	switch k {
+	case ZoneAbsent:
+		return "absent"
+	case ZoneUTC:
+		return "UTC"
+	case ZoneOffset:
+		return "offset"
+	case ZoneUnknown:
+		return "unknown"
+	}
+	return "ZoneKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// zoneLocation returns the location for an offset parsed by ParseISOOffset.
+func zoneLocation(offset int, kind ZoneKind, o *ParseOptions) *time.Location {
+	switch kind {
+	case ZoneUTC:
+		return time.UTC
+	case ZoneUnknown:
+		return UnknownOffset
+	}
+	if offset == 0 && o.ZeroOffsetUTC {
+		return time.UTC
+	}
+	return fixedZone(offset)
+}
+
+// maxZoneCache is the number of whole minute offsets from -23:59 to +23:59, which are always kept by the zone cache.
+// Offsets with seconds are only kept while the cache holds fewer locations than this,
+// and are otherwise returned as newly allocated locations.
+const maxZoneCache = 2*24*60 - 1
+
+// zoneCache interns fixed zone locations by their offset in seconds east of UTC,
+// so that parsing many inputs with the same offset does not allocate a new location for each.
+var zoneCache = struct {
+	sync.RWMutex
+	zones map[int]*time.Location
+}{
+	zones: make(map[int]*time.Location),
+}
+
+// fixedZone returns an unnamed location with the given offset in seconds east of UTC.
+// Locations are shared between callers and safe for concurrent use.
+func fixedZone(offset int) *time.Location {
+	zoneCache.RLock()
+	loc, ok := zoneCache.zones[offset]
+	full := len(zoneCache.zones) >= maxZoneCache
+	zoneCache.RUnlock()
+	if ok {
+		return loc
+	}
+
+	// A whole minute offset is always cached, so that the cache cannot be filled by other offsets
+	// before a common one such as `+05:30` is seen.
+	minute := offset%60 == 0 && offset > -86400 && offset < 86400
+	if !minute && full {
+		return time.FixedZone("", offset)
+	}
+
+	zoneCache.Lock()
+	defer zoneCache.Unlock()
+	if loc, ok = zoneCache.zones[offset]; ok {
+		return loc
+	}
+	loc = time.FixedZone("", offset)
+	if minute || len(zoneCache.zones) < maxZoneCache {
+		zoneCache.zones[offset] = loc
+	}
+	return loc
+}
+
+// DSTPolicy selects how a wall clock time that is skipped or repeated by a daylight saving time transition
+// is resolved to an instant in a location.
+type DSTPolicy uint8
+
+const (
+	// DSTDefault resolves the time in the same way as time.Date, which does not guarantee which instant is chosen.
+	DSTDefault DSTPolicy = iota
+	// DSTEarlier chooses the earlier of the two instants around the transition.
+	// A skipped time uses the offset after the transition (02:30 in a gap from 02:00 to 03:00 is 01:30 before it),
+	// and a repeated time its first occurrence.
+	DSTEarlier
+	// DSTLater chooses the later of the two instants around the transition.
+	// A skipped time uses the offset before the transition (02:30 in a gap from 02:00 to 03:00 is 03:30 after it),
+	// and a repeated time its second occurrence.
+	DSTLater
+	// DSTShiftForward shifts a skipped time forward by the length of the gap, like DSTLater,
+	// and chooses the first occurrence of a repeated time, like DSTEarlier.
+	DSTShiftForward
+	// DSTReject returns ErrNonexistentTime for a skipped time and ErrAmbiguousTime for a repeated time.
+	DSTReject
+)
+
+// wallTime returns the instant of the wall clock time in f in loc, resolving a skipped or repeated time with policy.
+func wallTime(f fields, loc *time.Location, policy DSTPolicy) (time.Time, error) {
+	wall := time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, 0, time.UTC).Unix()
+
+	// The offsets a day either side of the time are those before and after any transition at the time,
+	// as locations do not change their offset more than once within a day.
+	_, before := time.Unix(wall-86400, 0).In(loc).Zone()
+	_, after := time.Unix(wall+86400, 0).In(loc).Zone()
+	if before == after {
+		return time.Date(f.year, time.Month(f.month), f.day, f.hour, f.minute, f.second, f.nanosecond, loc), nil
+	}
+
+	// The wall clock time exists with an offset if the location has that offset at the resulting instant.
+	// The larger offset gives the earlier instant.
+	high, low := before, after
+	if high < low {
+		high, low = low, high
+	}
+	early, late := wall-int64(high), wall-int64(low)
+	_, earlyOffset := time.Unix(early, 0).In(loc).Zone()
+	_, lateOffset := time.Unix(late, 0).In(loc).Zone()
+	earlyValid, lateValid := earlyOffset == high, lateOffset == low
+
+	var sec int64
+	switch {
+	case earlyValid && lateValid:
+		// The time is repeated.
+		switch policy {
+		case DSTLater:
+			sec = late
+		case DSTReject:
+			return time.Time{}, ErrAmbiguousTime
+		default:
+			sec = early
+		}
+	case earlyValid:
+		sec = early
+	case lateValid:
+		sec = late
+	default:
+		// The time is skipped.
+		switch policy {
+		case DSTEarlier:
+			sec = early
+		case DSTReject:
+			return time.Time{}, ErrNonexistentTime
+		default:
+			sec = late
+		}
+	}
+	return time.Unix(sec, int64(f.nanosecond)).In(loc), nil
+}
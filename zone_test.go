@@ -0,0 +1,260 @@
+package iso8601
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFixedZoneCache(t *testing.T) {
+	a := fixedZone(7200)
+	b := fixedZone(7200)
+	if a != b {
+		t.Error("Expected the same location to be returned for the same offset")
+	}
+	if _, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, a).Zone(); offset != 7200 {
+		t.Errorf("Offset = %d; want 7200", offset)
+	}
+
+	x, err := ParseString("2017-04-24T09:41:34+02:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := ParseString("2018-01-01T00:00:00+0200")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Location() != y.Location() {
+		t.Error("Expected inputs with the same offset to share a location")
+	}
+}
+
+func TestFixedZoneConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for offset := -50400; offset <= 50400; offset += 900 {
+				loc := fixedZone(offset)
+				if _, got := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone(); got != offset {
+					t.Errorf("Offset = %d; want %d", got, offset)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestFixedZoneCacheFull(t *testing.T) {
+	// Fill the cache with offsets that have seconds before any whole minute offset below is seen.
+	for i := 0; i <= maxZoneCache; i++ {
+		fixedZone(i*60 + 1)
+	}
+
+	for _, s := range []string{
+		"2017-04-24T09:41:34-09:37",
+		"2017-04-24T09:41:34+13:45",
+	} {
+		inp := []byte(s)
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := Parse(inp); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("Parse(%q) allocated %v times after filling the zone cache; want 0", s, allocs)
+		}
+	}
+
+	if a, b := fixedZone(-(23*3600 + 59*60)), fixedZone(-(23*3600 + 59*60)); a != b {
+		t.Error("Expected the same location to be returned for a whole minute offset after filling the zone cache")
+	}
+}
+
+func TestZeroOffsetUTC(t *testing.T) {
+	opts := ParseOptions{ZeroOffsetUTC: true}
+	for _, s := range []string{"+00:00", "+0000", "+00"} {
+		loc, err := opts.ParseISOZone([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if loc != time.UTC {
+			t.Errorf("ParseISOZone(%q) = %v; want time.UTC", s, loc)
+		}
+	}
+
+	loc, err := ParseISOZone([]byte("+00:00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc == time.UTC {
+		t.Error("Expected a positive zero offset not to return time.UTC by default")
+	}
+
+	loc, err = opts.ParseISOZone([]byte("-00:00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc != UnknownOffset {
+		t.Errorf("ParseISOZone(%q) = %v; want UnknownOffset", "-00:00", loc)
+	}
+}
+
+func TestParseOffsetAllocs(t *testing.T) {
+	for _, s := range []string{
+		"2017-04-24T09:41:34.502Z",
+		"2017-04-24T09:41:34.502+02:00",
+		"2017-04-24T09:41:34.502-0530",
+	} {
+		inp := []byte(s)
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := Parse(inp); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("Parse(%q) allocated %v times; want 0", s, allocs)
+		}
+	}
+}
+
+func TestParseISOOffset(t *testing.T) {
+	var offsetCases = []struct {
+		Using   string
+		Seconds int
+		Kind    ZoneKind
+		Expect  error
+	}{
+		{Using: "Z", Kind: ZoneUTC},
+		{Using: "z", Kind: ZoneUTC},
+		{Using: "+00:00", Kind: ZoneOffset},
+		{Using: "+01:00", Seconds: 3600, Kind: ZoneOffset},
+		{Using: "-0530", Seconds: -19800, Kind: ZoneOffset},
+		{Using: "+05", Seconds: 18000, Kind: ZoneOffset},
+		{Using: "-00:00", Kind: ZoneUnknown},
+		{Using: "-00", Kind: ZoneUnknown},
+		{Using: "", Expect: ErrZoneCharacters},
+		{Using: "Zz", Expect: ErrRemainingData},
+		{Using: "^", Expect: UnexpectedCharacterError{Character: '^'}},
+	}
+
+	for _, tc := range offsetCases {
+		t.Run(tc.Using, func(t *testing.T) {
+			seconds, kind, err := ParseISOOffset([]byte(tc.Using))
+			if !errors.Is(err, tc.Expect) {
+				t.Fatalf("ParseISOOffset expected to return error %v (%T), got %v (%T)", tc.Expect, tc.Expect, err, err)
+			}
+			if seconds != tc.Seconds || kind != tc.Kind {
+				t.Errorf("ParseISOOffset = %d, %s; want %d, %s", seconds, kind, tc.Seconds, tc.Kind)
+			}
+		})
+	}
+
+	opts := ParseOptions{RejectUnknownOffset: true}
+	if _, _, err := opts.ParseISOOffset([]byte("-00:00")); !errors.Is(err, ErrInvalidZone) {
+		t.Errorf("ParseISOOffset with RejectUnknownOffset returned %v; want %v", err, ErrInvalidZone)
+	}
+}
+
+func TestParseISOOffsetAllocs(t *testing.T) {
+	inp := []byte("+05:45")
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, _, err := ParseISOOffset(inp); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseISOOffset allocated %v times; want 0", allocs)
+	}
+}
+
+func TestParseDST(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// The clocks go forward from 01:00 to 02:00 on the 28th of March 2021,
+	// and back from 02:00 to 01:00 on the 31st of October 2021.
+	var cases = []struct {
+		Using  string
+		Policy DSTPolicy
+		Expect time.Time
+		Err    error
+	}{
+		{Using: "2021-03-28T01:30:00.5", Policy: DSTEarlier, Expect: time.Date(2021, 3, 28, 0, 30, 0, 500000000, time.UTC)},
+		{Using: "2021-03-28T01:30:00.5", Policy: DSTLater, Expect: time.Date(2021, 3, 28, 1, 30, 0, 500000000, time.UTC)},
+		{Using: "2021-03-28T01:30:00.5", Policy: DSTShiftForward, Expect: time.Date(2021, 3, 28, 1, 30, 0, 500000000, time.UTC)},
+		{Using: "2021-03-28T01:30", Policy: DSTReject, Err: ErrNonexistentTime},
+		{Using: "2021-10-31T01:30", Policy: DSTEarlier, Expect: time.Date(2021, 10, 31, 0, 30, 0, 0, time.UTC)},
+		{Using: "2021-10-31T01:30", Policy: DSTLater, Expect: time.Date(2021, 10, 31, 1, 30, 0, 0, time.UTC)},
+		{Using: "2021-10-31T01:30", Policy: DSTShiftForward, Expect: time.Date(2021, 10, 31, 0, 30, 0, 0, time.UTC)},
+		{Using: "2021-10-31T01:30", Policy: DSTReject, Err: ErrAmbiguousTime},
+		{Using: "2021-03-28T02:30", Policy: DSTReject, Expect: time.Date(2021, 3, 28, 1, 30, 0, 0, time.UTC)},
+		{Using: "2021-03-28T00:59:59", Policy: DSTReject, Expect: time.Date(2021, 3, 28, 0, 59, 59, 0, time.UTC)},
+		{Using: "2021-10-31T02:00", Policy: DSTReject, Expect: time.Date(2021, 10, 31, 2, 0, 0, 0, time.UTC)},
+		{Using: "2021-06-01T12:00", Policy: DSTReject, Expect: time.Date(2021, 6, 1, 11, 0, 0, 0, time.UTC)},
+		{Using: "2021-03-28T01:30Z", Policy: DSTReject, Expect: time.Date(2021, 3, 28, 1, 30, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			d, err := ParseOptions{DST: c.Policy}.ParseStringInLocation(c.Using, london)
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+			if c.Err != nil {
+				return
+			}
+			if !d.Equal(c.Expect) {
+				t.Errorf("ParseStringInLocation = %s; want %s", d, c.Expect)
+			}
+		})
+	}
+}
+
+func TestParseConvertToLocation(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	var cases = []struct {
+		Using   string
+		Options ParseOptions
+		Expect  time.Time
+		Err     error
+	}{
+		{Using: "2021-06-01T12:00:00+01:00", Options: ParseOptions{ConvertToLocation: true}, Expect: time.Date(2021, 6, 1, 12, 0, 0, 0, london)},
+		{Using: "2021-06-01T12:00:00-04:00", Options: ParseOptions{ConvertToLocation: true}, Expect: time.Date(2021, 6, 1, 17, 0, 0, 0, london)},
+		{Using: "2021-06-01T11:00:00Z", Options: ParseOptions{ConvertToLocation: true}, Expect: time.Date(2021, 6, 1, 12, 0, 0, 0, london)},
+		{Using: "2021-06-01T12:00:00", Options: ParseOptions{ConvertToLocation: true}, Expect: time.Date(2021, 6, 1, 12, 0, 0, 0, london)},
+		{Using: "2021-06-01T12:00:00+01:00", Options: ParseOptions{MatchLocationOffset: true}, Expect: time.Date(2021, 6, 1, 12, 0, 0, 0, london)},
+		{Using: "2021-01-01T12:00:00Z", Options: ParseOptions{MatchLocationOffset: true}, Expect: time.Date(2021, 1, 1, 12, 0, 0, 0, london)},
+		{Using: "2021-06-01T12:00:00-00:00", Options: ParseOptions{MatchLocationOffset: true}, Expect: time.Date(2021, 6, 1, 13, 0, 0, 0, london)},
+		{Using: "2021-06-01T12:00:00Z", Options: ParseOptions{MatchLocationOffset: true}, Err: ErrOffsetMismatch},
+		{Using: "2021-01-01T12:00:00+01:00", Options: ParseOptions{MatchLocationOffset: true}, Err: ErrOffsetMismatch},
+		{Using: "2021-06-01T12:00:00-04:00", Options: ParseOptions{MatchLocationOffset: true}, Err: ErrOffsetMismatch},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Using, func(t *testing.T) {
+			d, err := c.Options.ParseStringInLocation(c.Using, london)
+			if !errors.Is(err, c.Err) {
+				t.Fatalf("expected to return error %v (%T), got %v (%T)", c.Err, c.Err, err, err)
+			}
+			if c.Err != nil {
+				return
+			}
+			if !d.Equal(c.Expect) {
+				t.Errorf("ParseStringInLocation = %s; want %s", d, c.Expect)
+			}
+			if d.Location() != london {
+				t.Errorf("Location = %s; want %s", d.Location(), london)
+			}
+		})
+	}
+}